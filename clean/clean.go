@@ -0,0 +1,97 @@
+// Package clean provides sanitizers for valid.Clean. A sanitizer is a
+// func(T) T that returns a cleaned copy of its input; sanitizers should be
+// idempotent so that cleaning twice is harmless.
+package clean
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// TrimSpace removes leading and trailing white space.
+func TrimSpace(s string) string { return strings.TrimSpace(s) }
+
+// Lower maps s to lower case.
+func Lower(s string) string { return strings.ToLower(s) }
+
+// Upper maps s to upper case.
+func Upper(s string) string { return strings.ToUpper(s) }
+
+// NFC converts s to Unicode Normalization Form C (see ishelper.NFC).
+func NFC(s string) string { return ishelper.NFC(s) }
+
+// CollapseSpace trims s and replaces every run of white space (including
+// newlines and tabs) with a single space.
+func CollapseSpace(s string) string { return strings.Join(strings.Fields(s), " ") }
+
+// StripControl removes control and format characters (e.g. NUL, zero-width
+// space, bidi overrides), keeping tabs and newlines.
+func StripControl(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '\t' || r == '\n' || r == '\r' {
+			return r
+		}
+		if unicode.IsControl(r) || unicode.Is(unicode.Cf, r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// Digits keeps only the ASCII digits of s, e.g. to store card or phone
+// numbers typed with separators.
+func Digits(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, s)
+}
+
+// Trim returns a sanitizer removing the leading and trailing characters
+// contained in cutset.
+func Trim(cutset string) func(string) string {
+	return func(s string) string { return strings.Trim(s, cutset) }
+}
+
+// Truncate returns a sanitizer keeping at most n runes.
+func Truncate(n int) func(string) string {
+	return func(s string) string {
+		if utf8.RuneCountInString(s) <= n {
+			return s
+		}
+		return string([]rune(s)[:n])
+	}
+}
+
+// Each returns a sanitizer applying sanitizers to every element of a slice.
+// Elements are modified in place.
+func Each[T any](sanitizers ...func(T) T) func([]T) []T {
+	return func(items []T) []T {
+		for i := range items {
+			for _, fn := range sanitizers {
+				items[i] = fn(items[i])
+			}
+		}
+		return items
+	}
+}
+
+// Compact returns a sanitizer dropping the zero-value elements of a slice,
+// e.g. empty strings left by TrimSpace. The result reuses the input array.
+func Compact[T comparable]() func([]T) []T {
+	return func(items []T) []T {
+		var zero T
+		out := items[:0]
+		for _, item := range items {
+			if item != zero {
+				out = append(out, item)
+			}
+		}
+		return out
+	}
+}
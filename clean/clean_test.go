@@ -0,0 +1,48 @@
+package clean_test
+
+import (
+	"testing"
+
+	"github.com/alexisvisco/valid/clean"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStringSanitizers(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "a b", clean.TrimSpace("\t a b \n"))
+	require.Equal(t, "straße", clean.Lower("STRAßE"))
+	require.Equal(t, "ABC", clean.Upper("abc"))
+	require.Equal(t, "Ren\u00e9", clean.NFC("Rene\u0301"))
+	require.Equal(t, "Jean Luc Picard", clean.CollapseSpace("  Jean \t Luc\n\nPicard "))
+	require.Equal(t, "ab\tc\n", clean.StripControl("a\x00b\u200b\tc\u202e\n"))
+	require.Equal(t, "4111111111111111", clean.Digits("4111 1111-1111 1111"))
+	require.Equal(t, "path", clean.Trim("/")("//path/"))
+	require.Equal(t, "héll", clean.Truncate(4)("héllo"))
+	require.Equal(t, "hé", clean.Truncate(4)("hé"))
+}
+
+func TestSliceSanitizers(t *testing.T) {
+	t.Parallel()
+
+	tags := []string{" Go ", "", "  ", "Rust"}
+	tags = clean.Each(clean.TrimSpace, clean.Lower)(tags)
+	require.Equal(t, []string{"go", "", "", "rust"}, tags)
+	require.Equal(t, []string{"go", "rust"}, clean.Compact[string]()(tags))
+	require.Empty(t, clean.Compact[int]()(nil))
+}
+
+func TestIdempotent(t *testing.T) {
+	t.Parallel()
+
+	for name, fn := range map[string]func(string) string{
+		"TrimSpace":     clean.TrimSpace,
+		"CollapseSpace": clean.CollapseSpace,
+		"NFC":           clean.NFC,
+		"StripControl":  clean.StripControl,
+		"Digits":        clean.Digits,
+	} {
+		in := "  A\u0301  b\x00 12\u200b "
+		require.Equal(t, fn(in), fn(fn(in)), name)
+	}
+}
@@ -0,0 +1,148 @@
+package valid
+
+import (
+	"context"
+	"reflect"
+	"slices"
+
+	"github.com/alexisvisco/valid/is"
+)
+
+// FieldSpec describes how a field is validated, as returned by Describe.
+type FieldSpec struct {
+	// Path is the path of the field relative to its parent, as given to
+	// Field or Nested. "*" stands for every element of a slice.
+	Path string
+	// Type is the type of the value given for the field, or nil if unknown.
+	Type reflect.Type
+	// Rules describes the rules of the field that carry an is.RuleSpec.
+	Rules []is.RuleSpec
+	// Fields describes the nested fields validated by Nested, Slice, Each or
+	// UniqueBy.
+	Fields []FieldSpec
+}
+
+// Describe returns the fields validated by groups and their rules, without
+// evaluating any rule. Groups built by this package record their fields
+// instead of validating; custom FieldGroup funcs are called and their errors
+// ignored.
+//
+// Nested, Slice, Each and UniqueBy describe the element type of slices with a
+// "*" field, calling the Valid method (or the Slice callback) on a zero
+// element, so only the groups built on the zero-value path of Valid are seen.
+// Recursive types are described once; inner occurrences only carry their
+// Type. Nested("", v) describes the fields of v at the top level.
+// Fields given several times (e.g. Field and Nested for the same path) are
+// merged in order.
+func Describe(groups ...FieldGroup) []FieldSpec {
+	d := &describer{}
+	for _, g := range groups {
+		d.run(context.Background(), func(ctx context.Context) { g(ctx) })
+	}
+	return d.fields
+}
+
+type describerKey struct{}
+
+// describer collects FieldSpecs while groups run in describe mode.
+type describer struct {
+	fields []FieldSpec
+	// types holds the types being described, to stop on recursive types.
+	types []reflect.Type
+}
+
+// describing returns the describer of ctx, or nil when groups validate.
+func describing(ctx context.Context) *describer {
+	d, _ := ctx.Value(describerKey{}).(*describer)
+	return d
+}
+
+// run calls fn with a context that makes groups record their fields in d.
+// Panics raised by fn, e.g. a Valid method dereferencing a nil field of a
+// zero value, stop the description of fn only.
+func (d *describer) run(ctx context.Context, fn func(ctx context.Context)) {
+	defer func() { _ = recover() }()
+	fn(context.WithValue(ctx, describerKey{}, d))
+}
+
+// add merges spec into the recorded fields. A spec with an empty path
+// contributes its fields at the top level.
+func (d *describer) add(spec FieldSpec) {
+	if spec.Path == "" {
+		for _, f := range spec.Fields {
+			d.fields = mergeField(d.fields, f)
+		}
+		return
+	}
+	d.fields = mergeField(d.fields, spec)
+}
+
+func mergeField(fields []FieldSpec, spec FieldSpec) []FieldSpec {
+	i := slices.IndexFunc(fields, func(f FieldSpec) bool { return f.Path == spec.Path })
+	if i < 0 {
+		return append(fields, spec)
+	}
+	f := &fields[i]
+	if f.Type == nil {
+		f.Type = spec.Type
+	}
+	f.Rules = append(f.Rules, spec.Rules...)
+	for _, child := range spec.Fields {
+		f.Fields = mergeField(f.Fields, child)
+	}
+	return fields
+}
+
+// element returns the spec of the elements of a slice of type t, with rules.
+func element(t reflect.Type, rules ...is.RuleSpec) FieldSpec {
+	return FieldSpec{Path: "*", Type: t.Elem(), Rules: rules}
+}
+
+// nested describes the fields of a Validatable of type t, or of the elements
+// of t if it is a slice.
+func (d *describer) nested(ctx context.Context, path string, t reflect.Type) FieldSpec {
+	spec := FieldSpec{Path: path, Type: t}
+	base := t
+	if base.Kind() == reflect.Pointer {
+		base = base.Elem()
+	}
+	if base.Kind() == reflect.Slice || base.Kind() == reflect.Array {
+		spec.Fields = []FieldSpec{d.nested(ctx, "*", base.Elem())}
+		return spec
+	}
+	if slices.Contains(d.types, base) {
+		return spec
+	}
+
+	zero := reflect.New(base)
+	val, ok := zero.Elem().Interface().(Validatable)
+	if !ok {
+		if val, ok = zero.Interface().(Validatable); !ok {
+			return spec
+		}
+	}
+	spec.Fields = d.collect(ctx, base, func(ctx context.Context) { _ = val.Valid(ctx) })
+	return spec
+}
+
+// bound describes the fields of a value bound to a Schema by Schema.Bind.
+func (d *describer) bound(ctx context.Context, path string, b binding) FieldSpec {
+	t := b.boundType()
+	spec := FieldSpec{Path: path, Type: t}
+	if slices.Contains(d.types, t) {
+		return spec
+	}
+	spec.Fields = d.collect(ctx, t, func(ctx context.Context) { _ = b.Valid(ctx) })
+	return spec
+}
+
+// collect runs fn in describe mode with a fresh field list and returns the
+// fields it records. t is the type being described, or nil.
+func (d *describer) collect(ctx context.Context, t reflect.Type, fn func(ctx context.Context)) []FieldSpec {
+	child := &describer{types: d.types}
+	if t != nil {
+		child.types = append(slices.Clip(d.types), t)
+	}
+	child.run(ctx, fn)
+	return child.fields
+}
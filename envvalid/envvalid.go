@@ -0,0 +1,237 @@
+// Package envvalid loads configuration structs from environment variables and
+// validates them, reporting every misconfigured variable at once.
+package envvalid
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/alexisvisco/valid"
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// Options configures LoadWith and MustLoad.
+type Options struct {
+	// Prefix is prepended to every variable name, e.g. "APP_".
+	Prefix string
+	// Lookup returns the value of a variable. Nil means os.LookupEnv.
+	Lookup func(name string) (string, bool)
+}
+
+// Load fills a new T from the environment with default Options.
+// See LoadWith.
+func Load[T any](ctx context.Context) (T, error) {
+	return LoadWith[T](ctx, Options{})
+}
+
+// LoadWith fills a new T from environment variables, then validates it with
+// the `validate` tags of its fields and its Valid method, if T or *T
+// implements valid.Validatable. T must be a struct or a pointer to a struct.
+//
+// Fields are read as follows:
+//   - `env:"PORT"` reads the variable Options.Prefix+"PORT"; fields without an
+//     env tag are skipped, except structs, whose fields are read as if they
+//     were declared in the parent;
+//   - a struct field with an env tag ("DB") prefixes the variables of its
+//     fields with "DB_";
+//   - `default:"8080"` is used when the variable is unset or empty;
+//   - strings, bools, numbers, time.Duration, url.URL, *url.URL and
+//     encoding.TextUnmarshaler types are parsed from the value, pointers stay
+//     nil when the variable is unset, and slices are split on "," (or the
+//     `sep` tag) with surrounding spaces trimmed.
+//
+// The result is a *valid.Error whose paths are variable names, listing in
+// order: values that cannot be parsed (is.ViolationType with param "type", or
+// is.ViolationURL), `validate` tag violations and the errors returned by Valid.
+// Paths returned by Valid that match the Go field names of a variable, as is
+// or lowercased ("DB.MaxConns", "db.maxconns"), or its lowercased env tags
+// ("db.max_conns") are renamed to its name ("APP_DB_MAX_CONNS"). Only the
+// first error of each variable is kept. Errors returned by Valid that are not
+// *valid.Error, and invalid tags, are returned unchanged.
+func LoadWith[T any](ctx context.Context, opts Options) (T, error) {
+	var cfg T
+	if opts.Lookup == nil {
+		opts.Lookup = os.LookupEnv
+	}
+
+	v := reflect.ValueOf(&cfg).Elem()
+	if v.Kind() == reflect.Pointer {
+		v.Set(reflect.New(v.Type().Elem()))
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return cfg, fmt.Errorf("envvalid: %s is not a struct", v.Type())
+	}
+
+	l := &loader{lookup: opts.Lookup, names: map[string]string{}}
+	l.structFields(v, opts.Prefix, "", "")
+	if l.err != nil {
+		return cfg, l.err
+	}
+
+	var validFields []valid.FieldError
+	var vv valid.Validatable
+	switch c := any(cfg).(type) {
+	case valid.Validatable:
+		vv = c
+	default:
+		vv, _ = any(&cfg).(valid.Validatable)
+	}
+	if vv != nil {
+		if err := vv.Valid(ctx); err != nil {
+			ve := valid.As(err)
+			if ve == nil {
+				return cfg, err
+			}
+			validFields = ve.Rename(l.names).Fields
+		}
+	}
+
+	groups := []valid.FieldGroup{func(context.Context) []valid.FieldError { return l.fields }}
+	groups = append(groups, l.rules...)
+	groups = append(groups, func(context.Context) []valid.FieldError { return validFields })
+	return cfg, valid.Struct(ctx, groups...)
+}
+
+// MustLoad is LoadWith with a background context. If the configuration is
+// invalid, it prints Table of the errors to standard error and exits with
+// status 1; other errors make it panic.
+func MustLoad[T any](opts Options) T {
+	cfg, err := LoadWith[T](context.Background(), opts)
+	if err == nil {
+		return cfg
+	}
+	ve := valid.As(err)
+	if ve == nil {
+		panic(err)
+	}
+	fmt.Fprint(os.Stderr, Table(ve))
+	os.Exit(1)
+	return cfg
+}
+
+// Table formats e as a two-column table of variable names and problems,
+// preceded by a summary line.
+func Table(e *valid.Error) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "invalid configuration: %d variable(s) misconfigured\n", len(e.Fields))
+	tw := tabwriter.NewWriter(&sb, 0, 0, 3, ' ', 0)
+	writeRow(tw, "VARIABLE", "PROBLEM")
+	for _, fe := range e.Fields {
+		writeRow(tw, fe.Path, fe.Message)
+	}
+	_ = tw.Flush()
+	return sb.String()
+}
+
+func writeRow(w io.Writer, name, problem string) {
+	fmt.Fprintf(w, "  %s\t%s\n", name, problem)
+}
+
+// loader fills struct fields from variables and collects parse errors and
+// the tag rules to evaluate.
+type loader struct {
+	lookup func(string) (string, bool)
+	fields []valid.FieldError
+	rules  []valid.FieldGroup
+	// names maps the paths of variables made of Go field names ("DB.MaxConns",
+	// "db.maxconns") or lowercased env tags ("db.max_conns") to variable
+	// names.
+	names map[string]string
+	err   error
+}
+
+// structFields loads the fields of v. goPath and envPath are the dotted paths
+// of v made of Go field names and of env tags, used to rename Valid errors.
+func (l *loader) structFields(v reflect.Value, prefix, goPath, envPath string) {
+	t := v.Type()
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name, hasName := f.Tag.Lookup("env")
+		if name == "-" {
+			continue
+		}
+		fv := v.Field(i)
+		fieldPath := joinPath(goPath, f.Name)
+
+		if ft := f.Type; ft.Kind() == reflect.Struct && !isScalar(ft) ||
+			ft.Kind() == reflect.Pointer && ft.Elem().Kind() == reflect.Struct && !isScalar(ft.Elem()) {
+			if fv.Kind() == reflect.Pointer {
+				fv.Set(reflect.New(ft.Elem()))
+				fv = fv.Elem()
+			}
+			if hasName && name != "" {
+				l.structFields(fv, prefix+name+"_", fieldPath, joinPath(envPath, name))
+			} else {
+				l.structFields(fv, prefix, fieldPath, envPath)
+			}
+			if l.err != nil {
+				return
+			}
+			continue
+		}
+		if !hasName || name == "" {
+			continue
+		}
+
+		l.names[strings.ToLower(joinPath(envPath, name))] = prefix + name
+		name = prefix + name
+		l.names[fieldPath] = name
+		l.names[strings.ToLower(fieldPath)] = name
+		if l.load(fv, name, f.Tag); l.err != nil {
+			return
+		}
+		rules, err := tagRules(f.Tag.Get("validate"))
+		if err != nil {
+			l.err = fmt.Errorf("envvalid: field %s: %w", fieldPath, err)
+			return
+		}
+		if len(rules) > 0 {
+			l.rules = append(l.rules, valid.Field(name, ruleValue(fv), rules...))
+		}
+	}
+}
+
+// load parses the variable name, or the default tag, into v.
+func (l *loader) load(v reflect.Value, name string, tag reflect.StructTag) {
+	raw, ok := l.lookup(name)
+	if !ok || raw == "" {
+		raw, ok = tag.Lookup("default")
+	}
+	if !ok {
+		return
+	}
+	if fe, err := parse(v, name, raw, tag.Get("sep")); err != nil {
+		l.err = err
+	} else if fe != nil {
+		l.fields = append(l.fields, *fe)
+	}
+}
+
+// ruleValue returns the value tag rules are evaluated against: the pointed-to
+// value for pointers, and None for nil pointers so that only is.Required
+// reports unset variables.
+func ruleValue(v reflect.Value) any {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return ishelper.None[struct{}]()
+		}
+		return v.Elem().Interface()
+	}
+	return v.Interface()
+}
+
+func joinPath(prefix, segment string) string {
+	if prefix == "" {
+		return segment
+	}
+	return prefix + "." + segment
+}
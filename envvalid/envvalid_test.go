@@ -0,0 +1,180 @@
+package envvalid_test
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/alexisvisco/valid"
+	"github.com/alexisvisco/valid/envvalid"
+	"github.com/alexisvisco/valid/is"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type database struct {
+	URL      *url.URL `env:"URL" validate:"required"`
+	MaxConns int      `env:"MAX_CONNS" default:"10" validate:"min=1,max=100"`
+}
+
+type Logging struct {
+	Level string `env:"LOG_LEVEL" default:"info" validate:"oneof=debug info warn error"`
+}
+
+type config struct {
+	Logging
+	Port     int           `env:"PORT" default:"8080" validate:"min=1,max=65535"`
+	Timeout  time.Duration `env:"TIMEOUT" default:"5s" validate:"min=1s"`
+	Hosts    []string      `env:"HOSTS"`
+	Ports    []int         `env:"ALT_PORTS" sep:";"`
+	Bind     netip.Addr    `env:"BIND" default:"0.0.0.0"`
+	Debug    bool          `env:"DEBUG"`
+	Admin    *string       `env:"ADMIN_EMAIL" validate:"email"`
+	DB       database      `env:"DB"`
+	Internal string
+}
+
+func (c *config) Valid(ctx context.Context) error {
+	return valid.Struct(ctx,
+		valid.Field("db.max_conns", c.DB.MaxConns, is.Max(50)),
+		valid.Field("DB.MaxConns", c.DB.MaxConns, is.Max(50)),
+		valid.Field("hosts", c.Hosts, is.MinItems(1)),
+	)
+}
+
+func env(vars map[string]string) envvalid.Options {
+	return envvalid.Options{
+		Prefix: "APP_",
+		Lookup: func(name string) (string, bool) {
+			v, ok := vars[name]
+			return v, ok
+		},
+	}
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	t.Run("values, defaults, prefixes and parsing", func(t *testing.T) {
+		t.Parallel()
+		cfg, err := envvalid.LoadWith[config](context.Background(), env(map[string]string{
+			"APP_PORT":         "9000",
+			"APP_HOSTS":        "a.example, b.example,",
+			"APP_ALT_PORTS":    "81;82",
+			"APP_DEBUG":        "true",
+			"APP_DB_URL":       "postgres://db:5432/app",
+			"APP_DB_MAX_CONNS": "",
+			"APP_LOG_LEVEL":    "warn",
+			"Internal":         "x",
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, 9000, cfg.Port)
+		assert.Equal(t, 5*time.Second, cfg.Timeout)
+		assert.Equal(t, []string{"a.example", "b.example"}, cfg.Hosts)
+		assert.Equal(t, []int{81, 82}, cfg.Ports)
+		assert.Equal(t, netip.MustParseAddr("0.0.0.0"), cfg.Bind)
+		assert.True(t, cfg.Debug)
+		assert.Nil(t, cfg.Admin)
+		assert.Equal(t, "db:5432", cfg.DB.URL.Host)
+		assert.Equal(t, 10, cfg.DB.MaxConns)
+		assert.Equal(t, "warn", cfg.Level)
+		assert.Empty(t, cfg.Internal)
+	})
+
+	t.Run("every problem reported at its variable name", func(t *testing.T) {
+		t.Parallel()
+		_, err := envvalid.LoadWith[*config](context.Background(), env(map[string]string{
+			"APP_PORT":         "http",
+			"APP_TIMEOUT":      "10ms",
+			"APP_ALT_PORTS":    "81;x",
+			"APP_DEBUG":        "maybe",
+			"APP_ADMIN_EMAIL":  "root",
+			"APP_DB_MAX_CONNS": "80",
+			"APP_LOG_LEVEL":    "verbose",
+		}))
+		ve := valid.As(err)
+		require.NotNil(t, ve)
+		assert.Equal(t, []valid.FieldError{
+			{Path: "APP_PORT", Code: string(is.ViolationType), Message: "must be of type integer", Params: map[string]any{"type": "integer"}},
+			{Path: "APP_ALT_PORTS", Code: string(is.ViolationType), Message: "must be of type integer", Params: map[string]any{"type": "integer", "index": 1}},
+			{Path: "APP_DEBUG", Code: string(is.ViolationType), Message: "must be of type boolean", Params: map[string]any{"type": "boolean"}},
+			{Path: "APP_LOG_LEVEL", Code: string(is.ViolationOneOf), Message: "must be one of debug, info, warn, error"},
+			{Path: "APP_TIMEOUT", Code: string(is.ViolationMin), Message: "must be >= 1s"},
+			{Path: "APP_ADMIN_EMAIL", Code: string(is.ViolationEmail), Message: "must be a valid email"},
+			{Path: "APP_DB_URL", Code: string(is.ViolationRequired), Message: "is required"},
+			{Path: "APP_DB_MAX_CONNS", Code: string(is.ViolationMax), Message: "must be <= 50"},
+			{Path: "APP_HOSTS", Code: string(is.ViolationMinItems), Message: "must contain at least 1 items", Params: map[string]any{"min": 1}},
+		}, ve.Fields)
+	})
+
+	t.Run("invalid URL", func(t *testing.T) {
+		t.Parallel()
+		_, err := envvalid.LoadWith[config](context.Background(), env(map[string]string{
+			"APP_DB_URL": "not a url",
+			"APP_HOSTS":  "a",
+		}))
+		ve := valid.As(err)
+		require.NotNil(t, ve)
+		assert.Equal(t, "APP_DB_URL", ve.Fields[0].Path)
+		assert.Equal(t, string(is.ViolationURL), ve.Fields[0].Code)
+	})
+
+	t.Run("non-validation error from Valid → returned as is", func(t *testing.T) {
+		t.Parallel()
+		_, err := envvalid.LoadWith[failing](context.Background(), env(nil))
+		require.ErrorIs(t, err, errVault)
+	})
+
+	t.Run("invalid tags and types → plain errors", func(t *testing.T) {
+		t.Parallel()
+		_, err := envvalid.LoadWith[badRule](context.Background(), env(nil))
+		require.ErrorContains(t, err, `unknown rule "big"`)
+		assert.Nil(t, valid.As(err))
+
+		_, err = envvalid.LoadWith[badType](context.Background(), env(map[string]string{"APP_M": "a=b"}))
+		require.Error(t, err)
+		assert.Nil(t, valid.As(err))
+
+		_, err = envvalid.LoadWith[int](context.Background(), env(nil))
+		require.Error(t, err)
+	})
+}
+
+var errVault = errors.New("vault unavailable")
+
+type failing struct{}
+
+func (failing) Valid(context.Context) error { return errVault }
+
+type badRule struct {
+	N int `env:"N" validate:"big"`
+}
+
+type badType struct {
+	M map[string]string `env:"M"`
+}
+
+func TestTable(t *testing.T) {
+	t.Parallel()
+
+	got := envvalid.Table(&valid.Error{Fields: []valid.FieldError{
+		{Path: "APP_PORT", Message: "must be of type integer"},
+		{Path: "APP_DATABASE_URL", Message: "is required"},
+	}})
+	assert.Equal(t, "invalid configuration: 2 variable(s) misconfigured\n"+
+		"  VARIABLE           PROBLEM\n"+
+		"  APP_PORT           must be of type integer\n"+
+		"  APP_DATABASE_URL   is required\n", got)
+}
+
+func TestMustLoad(t *testing.T) {
+	t.Parallel()
+
+	cfg := envvalid.MustLoad[config](env(map[string]string{"APP_DB_URL": "postgres://db", "APP_HOSTS": "a"}))
+	assert.Equal(t, 8080, cfg.Port)
+
+	assert.Panics(t, func() { envvalid.MustLoad[failing](env(nil)) })
+}
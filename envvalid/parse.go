@@ -0,0 +1,157 @@
+package envvalid
+
+import (
+	"encoding"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alexisvisco/valid"
+	"github.com/alexisvisco/valid/is"
+)
+
+var (
+	durationType        = reflect.TypeFor[time.Duration]()
+	urlType             = reflect.TypeFor[url.URL]()
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+)
+
+// parse sets v from raw. It returns a FieldError at name when raw cannot be
+// parsed, and an error when the type of v is not supported.
+func parse(v reflect.Value, name, raw, sep string) (*valid.FieldError, error) {
+	t := v.Type()
+	switch {
+	case t.Kind() == reflect.Pointer && isScalar(t.Elem()):
+		elem := reflect.New(t.Elem())
+		fe, err := parse(elem.Elem(), name, raw, sep)
+		if fe == nil && err == nil {
+			v.Set(elem)
+		}
+		return fe, err
+	case t.Kind() == reflect.Slice && isScalar(t.Elem()):
+		if sep == "" {
+			sep = ","
+		}
+		parts := strings.Split(raw, sep)
+		s := reflect.MakeSlice(t, 0, len(parts))
+		for i, part := range parts {
+			part = strings.TrimSpace(part)
+			if part == "" {
+				continue
+			}
+			elem := reflect.New(t.Elem()).Elem()
+			if !convert(elem, part) {
+				return typeError(name, t.Elem(), map[string]any{"index": i}), nil
+			}
+			s = reflect.Append(s, elem)
+		}
+		v.Set(s)
+		return nil, nil
+	case isScalar(t):
+		if !convert(v, raw) {
+			return typeError(name, t, nil), nil
+		}
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("envvalid: cannot load variable %s into type %s", name, t)
+	}
+}
+
+// isScalar reports whether values of type t are parsed from a single value.
+func isScalar(t reflect.Type) bool {
+	if t == urlType || reflect.PointerTo(t).Implements(textUnmarshalerType) {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// convert parses raw into v, which must be addressable and of a scalar type.
+func convert(v reflect.Value, raw string) bool {
+	switch t := v.Type(); {
+	case t == durationType:
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return false
+		}
+		v.SetInt(int64(d))
+		return true
+	case t == urlType:
+		u, err := url.Parse(raw)
+		if err != nil || u.Scheme == "" {
+			return false
+		}
+		v.Set(reflect.ValueOf(*u))
+		return true
+	case reflect.PointerTo(t).Implements(textUnmarshalerType):
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(raw)) == nil
+	}
+
+	var err error
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(raw)
+	case reflect.Bool:
+		var b bool
+		b, err = strconv.ParseBool(raw)
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var n int64
+		n, err = strconv.ParseInt(raw, 10, v.Type().Bits())
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var n uint64
+		n, err = strconv.ParseUint(raw, 10, v.Type().Bits())
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		var f float64
+		f, err = strconv.ParseFloat(raw, v.Type().Bits())
+		v.SetFloat(f)
+	}
+	return err == nil
+}
+
+// typeError reports that the variable name is not a valid value of type t:
+// is.ViolationURL for URLs, is.ViolationType with param "type" otherwise.
+func typeError(name string, t reflect.Type, params map[string]any) *valid.FieldError {
+	code := is.ViolationURL
+	if t != urlType {
+		code = is.ViolationType
+		if params == nil {
+			params = map[string]any{}
+		}
+		params["type"] = typeName(t)
+	}
+	return &valid.FieldError{Path: name, Code: string(code), Message: is.FormatMessage(code, params), Params: params}
+}
+
+// typeName returns the name reported when a value cannot be parsed as t.
+func typeName(t reflect.Type) string {
+	switch {
+	case t == durationType:
+		return "duration"
+	case reflect.PointerTo(t).Implements(textUnmarshalerType):
+		return strings.ToLower(t.Name())
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	default:
+		return "string"
+	}
+}
@@ -0,0 +1,91 @@
+package envvalid
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alexisvisco/valid/is"
+)
+
+// TagRules maps the rule names usable in `validate` tags to constructors
+// receiving the text after "=" (empty when there is none). Rules are separated
+// by commas, e.g. `validate:"required,min=1,max=65535"`, so arguments cannot
+// contain commas. Entries may be added or replaced at init.
+var TagRules = map[string]func(arg string) (is.Rule, error){
+	"required":    noArg(is.Required),
+	"email":       noArg(is.Email),
+	"url":         noArg(is.URL),
+	"uuid":        noArg(is.UUID),
+	"positive":    noArg(is.Positive),
+	"nonnegative": noArg(is.NonNegative),
+	"min":         numberArg(is.Min[float64], is.Min[time.Duration]),
+	"max":         numberArg(is.Max[float64], is.Max[time.Duration]),
+	"minlen":      intArg(is.MinLength),
+	"maxlen":      intArg(is.MaxLength),
+	"oneof": func(arg string) (is.Rule, error) {
+		values := strings.Fields(arg)
+		if len(values) == 0 {
+			return nil, errors.New("oneof needs space-separated values")
+		}
+		return is.OneOf(values...), nil
+	},
+	"matches": func(arg string) (is.Rule, error) {
+		if _, err := regexp.Compile(arg); err != nil {
+			return nil, fmt.Errorf("matches: %w", err)
+		}
+		return is.Matches(arg), nil
+	},
+}
+
+// tagRules builds the rules listed in a `validate` tag.
+func tagRules(tag string) ([]is.Rule, error) {
+	if tag == "" {
+		return nil, nil
+	}
+	var rules []is.Rule
+	for _, part := range strings.Split(tag, ",") {
+		name, arg, _ := strings.Cut(strings.TrimSpace(part), "=")
+		build, ok := TagRules[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown rule %q", name)
+		}
+		rule, err := build(arg)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+func noArg(rule is.Rule) func(string) (is.Rule, error) {
+	return func(string) (is.Rule, error) { return rule, nil }
+}
+
+// numberArg parses the argument as a number, or as a duration for
+// time.Duration fields (e.g. min=1s).
+func numberArg(number func(float64) is.Rule, duration func(time.Duration) is.Rule) func(string) (is.Rule, error) {
+	return func(arg string) (is.Rule, error) {
+		if n, err := strconv.ParseFloat(arg, 64); err == nil {
+			return number(n), nil
+		}
+		if d, err := time.ParseDuration(arg); err == nil {
+			return duration(d), nil
+		}
+		return nil, fmt.Errorf("invalid number %q", arg)
+	}
+}
+
+func intArg(build func(int) is.Rule) func(string) (is.Rule, error) {
+	return func(arg string) (is.Rule, error) {
+		n, err := strconv.Atoi(arg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid length %q", arg)
+		}
+		return build(n), nil
+	}
+}
@@ -0,0 +1,464 @@
+package httpvalid
+
+import (
+	"cmp"
+	"context"
+	"encoding"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alexisvisco/valid"
+	"github.com/alexisvisco/valid/is"
+)
+
+var (
+	timeType        = reflect.TypeFor[time.Time]()
+	durationType    = reflect.TypeFor[time.Duration]()
+	fileHeaderType  = reflect.TypeFor[*multipart.FileHeader]()
+	fileHeadersType = reflect.TypeFor[[]*multipart.FileHeader]()
+)
+
+// DecodeQuery fills a new T from the query parameters of r with BindQuery.
+func DecodeQuery[T valid.Validatable](r *http.Request) (T, error) {
+	return BindQuery[T](r.Context(), r.URL.Query())
+}
+
+// DecodeForm reads the form body of r into a new T with default Options.
+// See DecodeFormWith.
+func DecodeForm[T valid.Validatable](r *http.Request) (T, error) {
+	return DecodeFormWith[T](r, Options{})
+}
+
+// DecodeFormWith parses the multipart/form-data or
+// application/x-www-form-urlencoded body of r and fills a new T from it with
+// BindForm. Query parameters are not read.
+//
+// A malformed body is reported as is.ViolationForm at path "" (400) and a body
+// over Options.MaxBodyBytes as is.ViolationBodyTooLarge with param "limit"
+// (413). Options.AllowUnknownFields is ignored: parameters matching no field
+// are always skipped.
+func DecodeFormWith[T valid.Validatable](r *http.Request, opts Options) (T, error) {
+	var in T
+	limit := opts.MaxBodyBytes
+	if limit == 0 {
+		limit = DefaultMaxBodyBytes
+	}
+	r.Body = http.MaxBytesReader(nil, r.Body, limit)
+
+	var err error
+	form := &multipart.Form{}
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType == "multipart/form-data" {
+		if err = r.ParseMultipartForm(limit); err == nil {
+			form = r.MultipartForm
+		}
+	} else if err = r.ParseForm(); err == nil {
+		form.Value = r.PostForm
+	}
+
+	var maxErr *http.MaxBytesError
+	switch {
+	case errors.As(err, &maxErr):
+		return in, bodyError(http.StatusRequestEntityTooLarge, valid.FieldError{
+			Code:    string(is.ViolationBodyTooLarge),
+			Message: is.FormatMessage(is.ViolationBodyTooLarge, map[string]any{"limit": limit}),
+			Params:  map[string]any{"limit": limit},
+		})
+	case err != nil:
+		return in, bodyError(http.StatusBadRequest, valid.FieldError{
+			Code:    string(is.ViolationForm),
+			Message: is.FormatMessage(is.ViolationForm, nil),
+		})
+	}
+	return BindForm[T](r.Context(), form)
+}
+
+// BindQuery fills a new T from values using `query` struct tags, then calls
+// its Valid method. See BindForm for the binding rules.
+func BindQuery[T valid.Validatable](ctx context.Context, values url.Values) (T, error) {
+	return bind[T](ctx, "query", values, nil)
+}
+
+// BindForm fills a new T from form using `form` struct tags, then calls its
+// Valid method. T may be a pointer type, in which case a new value is
+// allocated.
+//
+// Fields are bound by tag name, or by Go field name when untagged; "-" skips a
+// field and embedded structs are flattened. Values are read as follows:
+//   - scalars (strings, bools, numbers, time.Time, time.Duration and
+//     encoding.TextUnmarshaler types) from the first value of their key;
+//     an empty value leaves a non-string field unset, and "on" is true;
+//   - slices of scalars from repeated keys ("tag=a&tag=b"), "tag[]" keys or
+//     indexed keys ("tag[0]");
+//   - nested structs and maps with string keys from bracket keys
+//     ("filter[status]", "filter[range][min]"), and slices of structs from
+//     indexed keys ("items[0][sku]"), in index order;
+//   - pointers are allocated only when a value is set below them;
+//   - *multipart.FileHeader and []*multipart.FileHeader from form.File.
+//
+// Parameters that match no field are ignored. Values that cannot be converted
+// are reported as is.ViolationType at the original parameter name
+// ("filter[min]") with param "type", plus "index" for repeated keys. Errors
+// returned by Valid are then appended for the paths not already reported.
+// The result is an *Error with status 400 if any value could not be
+// converted, 422 otherwise. Errors returned by Valid that are not
+// *valid.Error are returned unchanged, and so are errors for field types that
+// cannot be bound.
+func BindForm[T valid.Validatable](ctx context.Context, form *multipart.Form) (T, error) {
+	return bind[T](ctx, "form", form.Value, form.File)
+}
+
+func bind[T valid.Validatable](ctx context.Context, tag string, values map[string][]string, files map[string][]*multipart.FileHeader) (T, error) {
+	var in T
+	b := &binder{tag: tag, values: values, files: files}
+	b.value(reflect.ValueOf(target(&in)).Elem(), "")
+	if b.err != nil {
+		return in, b.err
+	}
+	return in, validate(ctx, in, b.fields)
+}
+
+// binder fills values from form parameters and collects conversion errors.
+type binder struct {
+	tag    string
+	values map[string][]string
+	files  map[string][]*multipart.FileHeader
+	fields []valid.FieldError
+	err    error
+	// set counts the values assigned so far, so that pointers and map entries
+	// are only created when something below them was set.
+	set int
+}
+
+// value fills v from the parameter name, or from the parameters nested under
+// it ("name[...]").
+func (b *binder) value(v reflect.Value, name string) {
+	t := v.Type()
+	switch {
+	case t == fileHeaderType:
+		if files := b.files[name]; len(files) > 0 {
+			v.Set(reflect.ValueOf(files[0]))
+			b.set++
+		}
+		return
+	case t == fileHeadersType:
+		if files := b.files[name]; len(files) > 0 {
+			v.Set(reflect.ValueOf(files))
+			b.set++
+		}
+		return
+	case isScalar(t):
+		if raw := b.lookup(name); len(raw) > 0 {
+			b.scalar(v, name, raw[0], nil)
+		}
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		if !b.has(name) {
+			// Nothing to bind below name; this also ends self-referential
+			// types such as Next *Node.
+			return
+		}
+		elem := reflect.New(t.Elem())
+		before := b.set
+		b.value(elem.Elem(), name)
+		if b.set > before {
+			v.Set(elem)
+		}
+	case reflect.Struct:
+		b.structFields(v, name)
+	case reflect.Slice:
+		b.slice(v, name)
+	case reflect.Map:
+		b.mapEntries(v, name)
+	default:
+		b.err = fmt.Errorf("httpvalid: cannot bind field %q of type %s", name, t)
+	}
+}
+
+func (b *binder) structFields(v reflect.Value, name string) {
+	t := v.Type()
+	for i := range t.NumField() {
+		f := t.Field(i)
+		key, _, _ := strings.Cut(f.Tag.Get(b.tag), ",")
+		if key == "-" {
+			continue
+		}
+		ft := f.Type
+		for ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if f.Anonymous && key == "" && ft.Kind() == reflect.Struct && !isScalar(ft) {
+			b.value(v.Field(i), name)
+			continue
+		}
+		if key == "" {
+			key = f.Name
+		}
+		b.value(v.Field(i), childKey(name, key))
+		if b.err != nil {
+			return
+		}
+	}
+}
+
+func (b *binder) slice(v reflect.Value, name string) {
+	t := v.Type()
+	segments := b.indices(name)
+	if isScalar(t.Elem()) {
+		raw := b.lookup(name)
+		s := reflect.MakeSlice(t, 0, len(raw)+len(segments))
+		before := b.set
+		for i, r := range raw {
+			elem := reflect.New(t.Elem()).Elem()
+			b.scalar(elem, name, r, map[string]any{"index": i})
+			s = reflect.Append(s, elem)
+		}
+		for _, seg := range segments {
+			key := childKey(name, seg)
+			if raw := b.lookup(key); len(raw) > 0 {
+				elem := reflect.New(t.Elem()).Elem()
+				b.scalar(elem, key, raw[0], nil)
+				s = reflect.Append(s, elem)
+			}
+		}
+		if b.set > before {
+			v.Set(s)
+		}
+		return
+	}
+
+	s := reflect.MakeSlice(t, 0, len(segments))
+	for _, seg := range segments {
+		elem := reflect.New(t.Elem()).Elem()
+		before := b.set
+		b.value(elem, childKey(name, seg))
+		if b.set > before {
+			s = reflect.Append(s, elem)
+		}
+	}
+	if s.Len() > 0 {
+		v.Set(s)
+	}
+}
+
+func (b *binder) mapEntries(v reflect.Value, name string) {
+	t := v.Type()
+	if t.Key().Kind() != reflect.String {
+		b.err = fmt.Errorf("httpvalid: cannot bind field %q of type %s", name, t)
+		return
+	}
+	for _, seg := range b.segments(name) {
+		elem := reflect.New(t.Elem()).Elem()
+		before := b.set
+		b.value(elem, childKey(name, seg))
+		if b.set > before {
+			if v.IsNil() {
+				v.Set(reflect.MakeMap(t))
+			}
+			v.SetMapIndex(reflect.ValueOf(seg).Convert(t.Key()), elem)
+		}
+	}
+}
+
+// scalar converts raw into v and records a conversion error at name.
+func (b *binder) scalar(v reflect.Value, name, raw string, params map[string]any) {
+	if raw == "" && v.Kind() != reflect.String {
+		return
+	}
+	if err := convert(v, raw); err != nil {
+		if params == nil {
+			params = map[string]any{}
+		}
+		params["type"] = formType(v.Type())
+		b.fields = append(b.fields, valid.FieldError{
+			Path:    name,
+			Code:    string(is.ViolationType),
+			Message: is.FormatMessage(is.ViolationType, params),
+			Params:  params,
+		})
+		return
+	}
+	b.set++
+}
+
+// has reports whether a parameter or file is named name or nested under it.
+func (b *binder) has(name string) bool {
+	if name == "" {
+		return true
+	}
+	prefix := name + "["
+	for key := range b.values {
+		if key == name || strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	for key := range b.files {
+		if key == name || strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// lookup returns the values of name followed by those of name+"[]".
+func (b *binder) lookup(name string) []string {
+	return append(slices.Clip(b.values[name]), b.values[name+"[]"]...)
+}
+
+// segments returns the distinct first bracket segments of the keys nested
+// under name, sorted.
+func (b *binder) segments(name string) []string {
+	prefix := name + "["
+	seen := map[string]bool{}
+	var segments []string
+	add := func(key string) {
+		rest, ok := strings.CutPrefix(key, prefix)
+		if !ok {
+			return
+		}
+		seg, _, ok := strings.Cut(rest, "]")
+		if !ok || seg == "" || seen[seg] {
+			return
+		}
+		seen[seg] = true
+		segments = append(segments, seg)
+	}
+	for key := range b.values {
+		add(key)
+	}
+	for key := range b.files {
+		add(key)
+	}
+	slices.Sort(segments)
+	return segments
+}
+
+// indices returns the numeric segments nested under name, in numeric order.
+func (b *binder) indices(name string) []string {
+	var indices []string
+	for _, seg := range b.segments(name) {
+		if _, err := strconv.ParseUint(seg, 10, 0); err == nil {
+			indices = append(indices, seg)
+		}
+	}
+	slices.SortFunc(indices, func(a, b string) int {
+		x, _ := strconv.ParseUint(a, 10, 0)
+		y, _ := strconv.ParseUint(b, 10, 0)
+		return cmp.Compare(x, y)
+	})
+	return indices
+}
+
+func childKey(name, key string) string {
+	if name == "" {
+		return key
+	}
+	return name + "[" + key + "]"
+}
+
+// isScalar reports whether values of type t are read from a single parameter.
+func isScalar(t reflect.Type) bool {
+	if t == timeType || reflect.PointerTo(t).Implements(textUnmarshalerType) {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// convert parses raw into v, which must be addressable and of a scalar type.
+func convert(v reflect.Value, raw string) error {
+	switch t := v.Type(); {
+	case t == timeType:
+		tm, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			tm, err = time.Parse(time.DateOnly, raw)
+		}
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(tm))
+		return nil
+	case t == durationType:
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	case reflect.PointerTo(t).Implements(textUnmarshalerType):
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(raw))
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(raw)
+	case reflect.Bool:
+		switch raw {
+		case "on":
+			v.SetBool(true)
+		case "off":
+			v.SetBool(false)
+		default:
+			ok, err := strconv.ParseBool(raw)
+			if err != nil {
+				return err
+			}
+			v.SetBool(ok)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(raw, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(raw, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	}
+	return nil
+}
+
+// formType returns the type name reported when a parameter cannot be
+// converted to t.
+func formType(t reflect.Type) string {
+	switch {
+	case t == timeType:
+		return "date-time"
+	case t == durationType:
+		return "duration"
+	case reflect.PointerTo(t).Implements(textUnmarshalerType):
+		return strings.ToLower(t.Name())
+	default:
+		return jsonType(t)
+	}
+}
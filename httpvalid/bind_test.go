@@ -0,0 +1,246 @@
+package httpvalid_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alexisvisco/valid"
+	"github.com/alexisvisco/valid/httpvalid"
+	"github.com/alexisvisco/valid/is"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type Paging struct {
+	Page  int `query:"page" form:"page"`
+	Limit int `query:"limit" form:"limit"`
+}
+
+type rangeFilter struct {
+	Min *float64 `query:"min"`
+	Max *float64 `query:"max"`
+}
+
+type search struct {
+	Paging
+	Q       string            `query:"q"`
+	Tags    []string          `query:"tag"`
+	IDs     []int             `query:"id"`
+	Filter  map[string]string `query:"filter"`
+	Price   *rangeFilter      `query:"price"`
+	Since   time.Time         `query:"since"`
+	Timeout time.Duration     `query:"timeout"`
+	Addr    netip.Addr        `query:"addr"`
+	Active  bool              `query:"active"`
+	Lines   []item            `query:"lines"`
+	Secret  string            `query:"-"`
+}
+
+func (s *search) Valid(ctx context.Context) error {
+	return valid.Struct(ctx,
+		valid.Field("q", s.Q, is.Required),
+		valid.Field("limit", s.Limit, is.Max(100)),
+	)
+}
+
+func bindFields(t *testing.T, query string) (int, []valid.FieldError) {
+	t.Helper()
+	values, err := url.ParseQuery(query)
+	require.NoError(t, err)
+	_, err = httpvalid.BindQuery[*search](context.Background(), values)
+	var he *httpvalid.Error
+	require.True(t, errors.As(err, &he), "got %v", err)
+	return he.Status, he.Err.Fields
+}
+
+func TestBindQuery(t *testing.T) {
+	t.Parallel()
+
+	t.Run("every supported shape", func(t *testing.T) {
+		t.Parallel()
+		values, err := url.ParseQuery("q=shoes&page=2&tag=a&tag=b&tag[]=c&id[1]=20&id[0]=10" +
+			"&filter[status]=open&filter[owner]=me&price[min]=9.5&since=2024-03-01&timeout=1m30s" +
+			"&addr=10.0.0.1&active=on&lines[1][SKU]=B&lines[0][SKU]=A&lines[0][Qty]=2&Secret=x&unknown=1")
+		require.NoError(t, err)
+
+		in, err := httpvalid.BindQuery[*search](context.Background(), values)
+		require.NoError(t, err)
+		minPrice := 9.5
+		assert.Equal(t, &search{
+			Paging:  Paging{Page: 2},
+			Q:       "shoes",
+			Tags:    []string{"a", "b", "c"},
+			IDs:     []int{10, 20},
+			Filter:  map[string]string{"status": "open", "owner": "me"},
+			Price:   &rangeFilter{Min: &minPrice},
+			Since:   time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			Timeout: 90 * time.Second,
+			Addr:    netip.MustParseAddr("10.0.0.1"),
+			Active:  true,
+			Lines:   []item{{SKU: "A", Qty: 2}, {SKU: "B"}},
+		}, in)
+	})
+
+	t.Run("absent and empty values → zero, nil pointers", func(t *testing.T) {
+		t.Parallel()
+		in, err := httpvalid.BindQuery[*search](context.Background(), url.Values{"q": {"x"}, "page": {""}, "price[min]": {""}})
+		require.NoError(t, err)
+		assert.Equal(t, &search{Q: "x"}, in)
+	})
+
+	t.Run("conversion errors → original parameter names, 400", func(t *testing.T) {
+		t.Parallel()
+		status, fields := bindFields(t, "q=x&page=two&id=1&id=x&price[max]=cheap&since=yesterday&addr=nope&lines[0][Qty]=1.5")
+		assert.Equal(t, http.StatusBadRequest, status)
+		assert.Equal(t, []valid.FieldError{
+			{Path: "page", Code: string(is.ViolationType), Message: "must be of type integer", Params: map[string]any{"type": "integer"}},
+			{Path: "id", Code: string(is.ViolationType), Message: "must be of type integer", Params: map[string]any{"type": "integer", "index": 1}},
+			{Path: "price[max]", Code: string(is.ViolationType), Message: "must be of type number", Params: map[string]any{"type": "number"}},
+			{Path: "since", Code: string(is.ViolationType), Message: "must be of type date-time", Params: map[string]any{"type": "date-time"}},
+			{Path: "addr", Code: string(is.ViolationType), Message: "must be of type addr", Params: map[string]any{"type": "addr"}},
+			{Path: "lines[0][Qty]", Code: string(is.ViolationType), Message: "must be of type integer", Params: map[string]any{"type": "integer"}},
+		}, fields)
+	})
+
+	t.Run("conversion and Valid errors → aggregated, 400", func(t *testing.T) {
+		t.Parallel()
+		status, fields := bindFields(t, "page=x&limit=500")
+		assert.Equal(t, http.StatusBadRequest, status)
+		var paths []string
+		for _, fe := range fields {
+			paths = append(paths, fe.Path)
+		}
+		assert.Equal(t, []string{"page", "q", "limit"}, paths)
+	})
+
+	t.Run("Valid errors only → 422", func(t *testing.T) {
+		t.Parallel()
+		status, fields := bindFields(t, "limit=500")
+		assert.Equal(t, http.StatusUnprocessableEntity, status)
+		assert.Len(t, fields, 2)
+	})
+
+	t.Run("self-referential pointers → bound as deep as the parameters", func(t *testing.T) {
+		t.Parallel()
+		in, err := httpvalid.BindQuery[linked](context.Background(), url.Values{"name": {"a"}, "next[next][name]": {"c"}})
+		require.NoError(t, err)
+		assert.Equal(t, linked{Name: "a", Next: &linked{Next: &linked{Name: "c"}}}, in)
+	})
+
+	t.Run("unsupported field type → plain error", func(t *testing.T) {
+		t.Parallel()
+		_, err := httpvalid.BindQuery[*unbindable](context.Background(), url.Values{})
+		require.Error(t, err)
+		assert.Nil(t, valid.As(err))
+	})
+}
+
+type linked struct {
+	Name string  `query:"name"`
+	Next *linked `query:"next"`
+}
+
+func (linked) Valid(context.Context) error { return nil }
+
+type unbindable struct {
+	Fn func() `query:"fn"`
+}
+
+func (*unbindable) Valid(context.Context) error { return nil }
+
+type upload struct {
+	Title       string                  `form:"title"`
+	Avatar      *multipart.FileHeader   `form:"avatar"`
+	Attachments []*multipart.FileHeader `form:"attachments"`
+}
+
+func (u upload) Valid(ctx context.Context) error {
+	return valid.Struct(ctx, valid.Field("title", u.Title, is.Required))
+}
+
+func TestDecodeForm(t *testing.T) {
+	t.Parallel()
+
+	t.Run("urlencoded body", func(t *testing.T) {
+		t.Parallel()
+		r := httptest.NewRequest(http.MethodPost, "/?title=query", strings.NewReader("title=body"))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		in, err := httpvalid.DecodeForm[upload](r)
+		require.NoError(t, err)
+		assert.Equal(t, "body", in.Title)
+	})
+
+	t.Run("multipart body with files", func(t *testing.T) {
+		t.Parallel()
+		var body bytes.Buffer
+		mw := multipart.NewWriter(&body)
+		require.NoError(t, mw.WriteField("title", "holiday"))
+		for _, name := range []string{"avatar", "attachments", "attachments"} {
+			fw, err := mw.CreateFormFile(name, name+".txt")
+			require.NoError(t, err)
+			_, _ = fw.Write([]byte("data"))
+		}
+		require.NoError(t, mw.Close())
+
+		r := httptest.NewRequest(http.MethodPost, "/", &body)
+		r.Header.Set("Content-Type", mw.FormDataContentType())
+		in, err := httpvalid.DecodeForm[upload](r)
+		require.NoError(t, err)
+		assert.Equal(t, "holiday", in.Title)
+		require.NotNil(t, in.Avatar)
+		assert.Equal(t, "avatar.txt", in.Avatar.Filename)
+		assert.Len(t, in.Attachments, 2)
+	})
+
+	t.Run("Valid failure → 422", func(t *testing.T) {
+		t.Parallel()
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("other=1"))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		_, err := httpvalid.DecodeForm[upload](r)
+		var he *httpvalid.Error
+		require.True(t, errors.As(err, &he))
+		assert.Equal(t, http.StatusUnprocessableEntity, he.Status)
+		assert.Equal(t, "title", he.Err.Fields[0].Path)
+	})
+
+	t.Run("malformed multipart → form violation", func(t *testing.T) {
+		t.Parallel()
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("garbage"))
+		r.Header.Set("Content-Type", "multipart/form-data; boundary=xyz")
+		_, err := httpvalid.DecodeForm[upload](r)
+		var he *httpvalid.Error
+		require.True(t, errors.As(err, &he))
+		assert.Equal(t, http.StatusBadRequest, he.Status)
+		assert.Equal(t, string(is.ViolationForm), he.Err.Fields[0].Code)
+	})
+
+	t.Run("body over limit → 413", func(t *testing.T) {
+		t.Parallel()
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("title="+strings.Repeat("a", 100)))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		_, err := httpvalid.DecodeFormWith[upload](r, httpvalid.Options{MaxBodyBytes: 64})
+		var he *httpvalid.Error
+		require.True(t, errors.As(err, &he))
+		assert.Equal(t, http.StatusRequestEntityTooLarge, he.Status)
+		assert.Equal(t, string(is.ViolationBodyTooLarge), he.Err.Fields[0].Code)
+	})
+}
+
+func TestDecodeQuery(t *testing.T) {
+	t.Parallel()
+
+	r := httptest.NewRequest(http.MethodGet, "/search?q=boots&filter[color]=red", nil)
+	in, err := httpvalid.DecodeQuery[*search](r)
+	require.NoError(t, err)
+	assert.Equal(t, "boots", in.Q)
+	assert.Equal(t, map[string]string{"color": "red"}, in.Filter)
+}
@@ -0,0 +1,249 @@
+// Package httpvalid decodes JSON request bodies, query parameters and forms,
+// validates them and writes validation errors as JSON responses.
+package httpvalid
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/alexisvisco/valid"
+	"github.com/alexisvisco/valid/is"
+)
+
+// DefaultMaxBodyBytes is the body size limit used when Options.MaxBodyBytes
+// is zero.
+const DefaultMaxBodyBytes int64 = 1 << 20
+
+// Options configures DecodeWith and HandlerWith.
+type Options struct {
+	// MaxBodyBytes limits the size of the request body. Zero means
+	// DefaultMaxBodyBytes.
+	MaxBodyBytes int64
+	// AllowUnknownFields accepts JSON keys that match no field of the target
+	// type instead of reporting them with is.ViolationUnknownField.
+	AllowUnknownFields bool
+}
+
+// Error is returned by Decode when the request is invalid. It carries the
+// HTTP status to respond with and unwraps to the *valid.Error describing the
+// problems, so valid.As works on it.
+type Error struct {
+	Status int
+	Err    *valid.Error
+}
+
+func (e *Error) Error() string { return e.Err.Error() }
+
+func (e *Error) Unwrap() error { return e.Err }
+
+// Decode reads the JSON body of r into a new T with default Options, then
+// calls its Valid method with the request context. See DecodeWith.
+func Decode[T valid.Validatable](r *http.Request) (T, error) {
+	return DecodeWith[T](r, Options{})
+}
+
+// DecodeWith reads the JSON body of r into a new T, then calls its Valid
+// method with the request context. T may be a pointer type, in which case a
+// new value is allocated.
+//
+// Problems with the body are returned as *Error with a *valid.Error whose
+// paths are JSON paths ("items.1.qty"):
+//   - empty body: is.ViolationRequired at path "" (400);
+//   - malformed JSON: is.ViolationJSON at path "" with param "offset" (400);
+//   - wrong JSON type: is.ViolationType with params "type" and "actual" (400);
+//   - unknown fields: is.ViolationUnknownField, one per field, at path ""
+//     when rejected by a json.Unmarshaler (400);
+//   - body over the limit: is.ViolationBodyTooLarge with param "limit" (413).
+//
+// Validation failures are returned as *Error with status 422. Other errors
+// returned by Valid are returned unchanged.
+func DecodeWith[T valid.Validatable](r *http.Request, opts Options) (T, error) {
+	var in T
+	limit := opts.MaxBodyBytes
+	if limit == 0 {
+		limit = DefaultMaxBodyBytes
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, limit+1))
+	if err != nil {
+		return in, err
+	}
+	if int64(len(body)) > limit {
+		return in, bodyError(http.StatusRequestEntityTooLarge, valid.FieldError{
+			Code:    string(is.ViolationBodyTooLarge),
+			Message: is.FormatMessage(is.ViolationBodyTooLarge, map[string]any{"limit": limit}),
+			Params:  map[string]any{"limit": limit},
+		})
+	}
+
+	if err := decodeJSON(body, target(&in), opts.AllowUnknownFields); err != nil {
+		return in, err
+	}
+	return in, validate(r.Context(), in, nil)
+}
+
+// target returns the pointer a decoder should fill so that *in receives the
+// value, allocating it when T is a pointer type.
+func target[T any](in *T) any {
+	if t := reflect.TypeFor[T](); t.Kind() == reflect.Pointer {
+		v := reflect.New(t.Elem())
+		reflect.ValueOf(in).Elem().Set(v)
+		return v.Interface()
+	}
+	return in
+}
+
+// validate calls in.Valid and aggregates its FieldErrors after the decoding
+// errors, skipping paths already reported. The result is an *Error with
+// status 400 if there are decoding errors, 422 otherwise. Errors returned by
+// Valid that are not *valid.Error are returned unchanged.
+func validate[T valid.Validatable](ctx context.Context, in T, decoding []valid.FieldError) error {
+	var validFields []valid.FieldError
+	if err := in.Valid(ctx); err != nil {
+		ve := valid.As(err)
+		if ve == nil {
+			return err
+		}
+		validFields = ve.Fields
+	}
+	err := valid.Struct(ctx,
+		func(context.Context) []valid.FieldError { return decoding },
+		func(context.Context) []valid.FieldError { return validFields },
+	)
+	if err == nil {
+		return nil
+	}
+	status := http.StatusUnprocessableEntity
+	if len(decoding) > 0 {
+		status = http.StatusBadRequest
+	}
+	return &Error{Status: status, Err: valid.As(err)}
+}
+
+// Handler returns an http.Handler that decodes and validates the request with
+// Decode, writes the error with WriteError on failure, and calls fn otherwise.
+func Handler[T valid.Validatable](fn func(w http.ResponseWriter, r *http.Request, in T)) http.Handler {
+	return HandlerWith(Options{}, fn)
+}
+
+// HandlerWith is Handler with explicit Options.
+func HandlerWith[T valid.Validatable](opts Options, fn func(w http.ResponseWriter, r *http.Request, in T)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		in, err := DecodeWith[T](r, opts)
+		if err != nil {
+			WriteError(w, err)
+			return
+		}
+		fn(w, r, in)
+	})
+}
+
+// decodeJSON unmarshals body into dst and converts decoding errors to *Error.
+func decodeJSON(body []byte, dst any, allowUnknown bool) error {
+	if len(strings.TrimSpace(string(body))) == 0 {
+		return bodyError(http.StatusBadRequest, valid.FieldError{
+			Code:    string(is.ViolationRequired),
+			Message: is.FormatMessage(is.ViolationRequired, nil),
+		})
+	}
+
+	dec := json.NewDecoder(strings.NewReader(string(body)))
+	if !allowUnknown {
+		dec.DisallowUnknownFields()
+	}
+	err := dec.Decode(dst)
+	if err == nil {
+		// Reject trailing data after the JSON value.
+		if _, tokErr := dec.Token(); tokErr != io.EOF {
+			err = &json.SyntaxError{Offset: dec.InputOffset()}
+		}
+	}
+	if err == nil {
+		return nil
+	}
+
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &syntaxErr):
+		params := map[string]any{"offset": syntaxErr.Offset}
+		return bodyError(http.StatusBadRequest, valid.FieldError{
+			Code:    string(is.ViolationJSON),
+			Message: is.FormatMessage(is.ViolationJSON, params),
+			Params:  params,
+		})
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		params := map[string]any{"offset": int64(len(body))}
+		return bodyError(http.StatusBadRequest, valid.FieldError{
+			Code:    string(is.ViolationJSON),
+			Message: is.FormatMessage(is.ViolationJSON, params),
+			Params:  params,
+		})
+	case errors.As(err, &typeErr):
+		actual, _, _ := strings.Cut(typeErr.Value, " ")
+		params := map[string]any{"type": jsonType(typeErr.Type), "actual": actual}
+		return bodyError(http.StatusBadRequest, valid.FieldError{
+			Path:    typeErr.Field,
+			Code:    string(is.ViolationType),
+			Message: is.FormatMessage(is.ViolationType, params),
+			Params:  params,
+		})
+	case strings.HasPrefix(err.Error(), "json: unknown field "):
+		var doc any
+		var paths []string
+		if json.Unmarshal(body, &doc) == nil {
+			paths = unknownFields(doc, reflect.TypeOf(dst), "")
+		}
+		if len(paths) == 0 {
+			// Reported by a json.Unmarshaler: the field cannot be located.
+			paths = []string{""}
+		}
+		fields := make([]valid.FieldError, len(paths))
+		for i, p := range paths {
+			fields[i] = valid.FieldError{
+				Path:    p,
+				Code:    string(is.ViolationUnknownField),
+				Message: is.FormatMessage(is.ViolationUnknownField, nil),
+			}
+		}
+		return bodyError(http.StatusBadRequest, fields...)
+	default:
+		return err
+	}
+}
+
+func bodyError(status int, fields ...valid.FieldError) *Error {
+	return &Error{Status: status, Err: &valid.Error{Fields: fields}}
+}
+
+// jsonType returns the JSON type name expected for Go type t.
+func jsonType(t reflect.Type) string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return "string"
+		}
+		return "array"
+	case reflect.Array:
+		return "array"
+	default:
+		return "object"
+	}
+}
@@ -0,0 +1,246 @@
+package httpvalid_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alexisvisco/valid"
+	"github.com/alexisvisco/valid/clean"
+	"github.com/alexisvisco/valid/httpvalid"
+	"github.com/alexisvisco/valid/is"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type item struct {
+	SKU string `json:"sku"`
+	Qty int    `json:"qty"`
+}
+
+func (it item) Valid(ctx context.Context) error {
+	return valid.Struct(ctx,
+		valid.Field("sku", it.SKU, is.Required),
+		valid.Field("qty", it.Qty, is.Positive),
+	)
+}
+
+type Audit struct {
+	Source string `json:"source"`
+}
+
+type order struct {
+	Audit
+	Email    string          `json:"email"`
+	Items    []item          `json:"items"`
+	Meta     map[string]item `json:"meta"`
+	Due      time.Time       `json:"due"`
+	Internal string          `json:"-"`
+}
+
+func (o *order) Valid(ctx context.Context) error {
+	return valid.Struct(ctx,
+		valid.Clean(&o.Email, clean.TrimSpace),
+		valid.Field("email", o.Email, is.Required, is.Email),
+		valid.Field("items", o.Items, is.MinItems(1)),
+		valid.Nested("items", o.Items),
+	)
+}
+
+// strict rejects unknown fields itself, out of reach of the path walker.
+type strict struct {
+	Name string `json:"name"`
+}
+
+func (s *strict) UnmarshalJSON(b []byte) error {
+	type plain strict
+	dec := json.NewDecoder(strings.NewReader(string(b)))
+	dec.DisallowUnknownFields()
+	return dec.Decode((*plain)(s))
+}
+
+type wrapper struct {
+	Inner strict `json:"inner"`
+}
+
+func (w *wrapper) Valid(context.Context) error { return nil }
+
+func request(body string) *http.Request {
+	return httptest.NewRequest(http.MethodPost, "/orders", strings.NewReader(body))
+}
+
+func decodeFields(t *testing.T, body string, opts httpvalid.Options) (int, []valid.FieldError) {
+	t.Helper()
+	_, err := httpvalid.DecodeWith[*order](request(body), opts)
+	var he *httpvalid.Error
+	require.True(t, errors.As(err, &he), "got %v", err)
+	return he.Status, he.Err.Fields
+}
+
+func TestDecode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid body → decoded and cleaned", func(t *testing.T) {
+		t.Parallel()
+		in, err := httpvalid.Decode[*order](request(`{"email":" a@b.co ","items":[{"sku":"A","qty":1}],"source":"web"}`))
+		require.NoError(t, err)
+		assert.Equal(t, "a@b.co", in.Email)
+		assert.Equal(t, "web", in.Source)
+	})
+
+	t.Run("non-pointer type", func(t *testing.T) {
+		t.Parallel()
+		in, err := httpvalid.Decode[item](request(`{"sku":"A","qty":2}`))
+		require.NoError(t, err)
+		assert.Equal(t, item{SKU: "A", Qty: 2}, in)
+	})
+
+	t.Run("Valid failure → 422 with paths from Valid", func(t *testing.T) {
+		t.Parallel()
+		status, fields := decodeFields(t, `{"email":"x","items":[{"sku":"A","qty":0}]}`, httpvalid.Options{})
+		assert.Equal(t, http.StatusUnprocessableEntity, status)
+		require.Len(t, fields, 2)
+		assert.Equal(t, "email", fields[0].Path)
+		assert.Equal(t, "items.0.qty", fields[1].Path)
+	})
+
+	t.Run("empty body → required at root", func(t *testing.T) {
+		t.Parallel()
+		status, fields := decodeFields(t, "  ", httpvalid.Options{})
+		assert.Equal(t, http.StatusBadRequest, status)
+		assert.Equal(t, []valid.FieldError{{Code: string(is.ViolationRequired), Message: "is required"}}, fields)
+	})
+
+	t.Run("syntax error → JSON violation with offset", func(t *testing.T) {
+		t.Parallel()
+		status, fields := decodeFields(t, `{"email": "x",}`, httpvalid.Options{})
+		assert.Equal(t, http.StatusBadRequest, status)
+		require.Len(t, fields, 1)
+		assert.Equal(t, string(is.ViolationJSON), fields[0].Code)
+		assert.Equal(t, map[string]any{"offset": int64(15)}, fields[0].Params)
+	})
+
+	t.Run("truncated body and trailing data → JSON violation", func(t *testing.T) {
+		t.Parallel()
+		_, fields := decodeFields(t, `{"email": "x"`, httpvalid.Options{})
+		assert.Equal(t, string(is.ViolationJSON), fields[0].Code)
+		_, fields = decodeFields(t, `{"email": "x"} {}`, httpvalid.Options{})
+		assert.Equal(t, string(is.ViolationJSON), fields[0].Code)
+	})
+
+	t.Run("type error → JSON path", func(t *testing.T) {
+		t.Parallel()
+		status, fields := decodeFields(t, `{"items":[{"sku":"A","qty":1},{"sku":"B","qty":"2"}]}`, httpvalid.Options{})
+		assert.Equal(t, http.StatusBadRequest, status)
+		assert.Equal(t, []valid.FieldError{{
+			Path:    "items.1.qty",
+			Code:    string(is.ViolationType),
+			Message: "must be of type integer",
+			Params:  map[string]any{"type": "integer", "actual": "string"},
+		}}, fields)
+	})
+
+	t.Run("unknown fields → every one reported at its path", func(t *testing.T) {
+		t.Parallel()
+		status, fields := decodeFields(t,
+			`{"email":"a@b.co","Internal":"x","items":[{"sku":"A","qty":1,"price":3}],"meta":{"k":{"SKU":"B","color":"red"}},"due":"2024-01-01T00:00:00Z","SOURCE":"web"}`,
+			httpvalid.Options{})
+		assert.Equal(t, http.StatusBadRequest, status)
+		var paths []string
+		for _, fe := range fields {
+			assert.Equal(t, string(is.ViolationUnknownField), fe.Code)
+			paths = append(paths, fe.Path)
+		}
+		assert.Equal(t, []string{"Internal", "items.0.price", "meta.k.color"}, paths)
+	})
+
+	t.Run("unknown field reported by an Unmarshaler → empty path", func(t *testing.T) {
+		t.Parallel()
+		_, err := httpvalid.Decode[*wrapper](request(`{"inner":{"name":"a","x":1}}`))
+		var he *httpvalid.Error
+		require.True(t, errors.As(err, &he), "got %v", err)
+		assert.Equal(t, http.StatusBadRequest, he.Status)
+		assert.Equal(t, []valid.FieldError{{Code: string(is.ViolationUnknownField), Message: "is not allowed"}}, he.Err.Fields)
+	})
+
+	t.Run("unknown fields allowed", func(t *testing.T) {
+		t.Parallel()
+		_, err := httpvalid.DecodeWith[*order](request(`{"email":"a@b.co","items":[{"sku":"A","qty":1}],"x":1}`),
+			httpvalid.Options{AllowUnknownFields: true})
+		require.NoError(t, err)
+	})
+
+	t.Run("body over limit → 413", func(t *testing.T) {
+		t.Parallel()
+		status, fields := decodeFields(t, `{"email":"`+strings.Repeat("a", 100)+`"}`, httpvalid.Options{MaxBodyBytes: 64})
+		assert.Equal(t, http.StatusRequestEntityTooLarge, status)
+		assert.Equal(t, string(is.ViolationBodyTooLarge), fields[0].Code)
+		assert.Equal(t, "must not exceed 64 bytes", fields[0].Message)
+	})
+
+	t.Run("non-validation error from Valid → returned as is", func(t *testing.T) {
+		t.Parallel()
+		_, err := httpvalid.Decode[failing](request(`{}`))
+		require.ErrorIs(t, err, errDatabase)
+		require.Nil(t, valid.As(err))
+	})
+}
+
+var errDatabase = errors.New("database unavailable")
+
+type failing struct{}
+
+func (failing) Valid(context.Context) error { return errDatabase }
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	h := httpvalid.Handler(func(w http.ResponseWriter, _ *http.Request, in *order) {
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(in.Email))
+	})
+
+	t.Run("valid → handler called", func(t *testing.T) {
+		t.Parallel()
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, request(`{"email":"a@b.co","items":[{"sku":"A","qty":1}]}`))
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Equal(t, "a@b.co", rec.Body.String())
+	})
+
+	t.Run("invalid → standard error response", func(t *testing.T) {
+		t.Parallel()
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, request(`{"email":"nope","items":[]}`))
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+		assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+		assert.JSONEq(t, `{
+			"code": "VALIDATION_FAILED",
+			"message": "validation failed",
+			"fields": [
+				{"path": "email", "code": "VALIDATION_EMAIL", "message": "must be a valid email"},
+				{"path": "items", "code": "VALIDATION_MIN_ITEMS", "message": "must contain at least 1 items", "params": {"min": 1}}
+			]
+		}`, rec.Body.String())
+	})
+}
+
+func TestWriteError(t *testing.T) {
+	t.Parallel()
+
+	rec := httptest.NewRecorder()
+	httpvalid.WriteError(rec, errDatabase)
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	var resp httpvalid.ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, httpvalid.ErrorResponse{Code: httpvalid.CodeInternalError, Message: "internal error"}, resp)
+
+	rec = httptest.NewRecorder()
+	httpvalid.WriteError(rec, &valid.Error{Fields: []valid.FieldError{{Path: "a", Code: "X"}}})
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
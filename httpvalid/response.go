@@ -0,0 +1,61 @@
+package httpvalid
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/alexisvisco/valid"
+)
+
+// Response codes of ErrorResponse.
+const (
+	CodeValidationFailed = "VALIDATION_FAILED"
+	CodeInternalError    = "INTERNAL_ERROR"
+)
+
+// ErrorResponse is the JSON body written by WriteError.
+type ErrorResponse struct {
+	Code    string               `json:"code"`
+	Message string               `json:"message"`
+	Fields  []FieldErrorResponse `json:"fields,omitempty"`
+}
+
+// FieldErrorResponse is the JSON form of a valid.FieldError.
+type FieldErrorResponse struct {
+	Path    string         `json:"path"`
+	Code    string         `json:"code"`
+	Message string         `json:"message"`
+	Params  map[string]any `json:"params,omitempty"`
+}
+
+// NewErrorResponse converts a *valid.Error to its JSON form.
+func NewErrorResponse(e *valid.Error) ErrorResponse {
+	fields := make([]FieldErrorResponse, len(e.Fields))
+	for i, fe := range e.Fields {
+		fields[i] = FieldErrorResponse{Path: fe.Path, Code: fe.Code, Message: fe.Message, Params: fe.Params}
+	}
+	return ErrorResponse{Code: CodeValidationFailed, Message: "validation failed", Fields: fields}
+}
+
+// WriteError writes err as a JSON ErrorResponse:
+//   - *Error: its Status and fields;
+//   - other errors wrapping a *valid.Error: 422 and its fields;
+//   - anything else: 500 with CodeInternalError and no details.
+func WriteError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	resp := ErrorResponse{Code: CodeInternalError, Message: "internal error"}
+
+	var he *Error
+	if errors.As(err, &he) {
+		status = he.Status
+		resp = NewErrorResponse(he.Err)
+	} else if ve := valid.As(err); ve != nil {
+		status = http.StatusUnprocessableEntity
+		resp = NewErrorResponse(ve)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(resp)
+}
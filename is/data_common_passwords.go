@@ -0,0 +1,28 @@
+package is
+
+// CommonPasswords is a small list of the most common leaked passwords,
+// lowercased. It is meant as a sensible default for PasswordPolicy.Banned and
+// may be modified at init time; plug a larger list through the
+// BannedPasswords interface.
+var CommonPasswords = PasswordSet{
+	"123456": true, "123456789": true, "12345678": true, "12345": true, "1234567": true,
+	"1234567890": true, "123123": true, "123321": true, "111111": true, "000000": true,
+	"654321": true, "666666": true, "121212": true, "112233": true, "987654321": true,
+	"11111111": true, "88888888": true, "1q2w3e4r": true, "1q2w3e": true, "1qaz2wsx": true,
+	"qwerty": true, "qwerty123": true, "qwertyuiop": true, "qwe123": true, "asdfgh": true,
+	"asdfghjkl": true, "zxcvbnm": true, "azerty": true, "password": true, "password1": true,
+	"password123": true, "passw0rd": true, "p@ssw0rd": true, "p@ssword": true, "abc123": true,
+	"abcd1234": true, "admin": true, "admin123": true, "root": true, "toor": true, "letmein": true,
+	"welcome": true, "welcome1": true, "welcome123": true, "iloveyou": true, "monkey": true,
+	"dragon": true, "master": true, "login": true, "princess": true, "sunshine": true, "shadow": true,
+	"football": true, "baseball": true, "superman": true, "batman": true, "trustno1": true,
+	"starwars": true, "whatever": true, "freedom": true, "hello": true, "hello123": true,
+	"charlie": true, "donald": true, "michael": true, "jessica": true, "ashley": true, "jordan": true,
+	"qazwsx": true, "1qazxsw2": true, "zaq12wsx": true, "changeme": true, "secret": true,
+	"secret123": true, "test": true, "test123": true, "guest": true, "default": true,
+	"computer": true, "internet": true, "access": true, "mustang": true, "hunter": true,
+	"killer": true, "soccer": true, "hockey": true, "ranger": true, "buster": true, "pepper": true,
+	"ginger": true, "cheese": true, "summer": true, "winter": true, "spring": true, "autumn": true,
+	"flower": true, "lovely": true, "696969": true, "777777": true, "555555": true, "999999": true,
+	"123qwe": true, "q1w2e3r4": true, "a123456": true, "aa123456": true, "123abc": true,
+}
@@ -0,0 +1,112 @@
+package is
+
+// PhonePlan describes the numbering plan of a region for PhoneNumber and
+// ParsePhone. Lengths apply to the national significant number, i.e. without
+// calling code and trunk prefix.
+type PhonePlan struct {
+	// CallingCode is the ITU country calling code, without "+".
+	CallingCode string
+	// TrunkPrefix is the national dialing prefix stripped from numbers written
+	// in national format (e.g. "0" in "01 23 45 67 89"), if any.
+	TrunkPrefix string
+	// MinLength and MaxLength bound the national significant number length.
+	MinLength, MaxLength int
+	// MobilePrefixes lists the national number prefixes of mobile numbers.
+	// When empty, the number type cannot be detected.
+	MobilePrefixes []string
+	// LeadingDigits distinguishes regions sharing a calling code (e.g. Canadian
+	// area codes within +1). A region without LeadingDigits is the default for
+	// its calling code.
+	LeadingDigits []string
+}
+
+// PhonePlans maps ISO 3166-1 alpha-2 region codes to their numbering plan. It
+// is used by PhoneNumber and ParsePhone and may be modified at init time to
+// add regions or refine plans.
+var PhonePlans = map[string]PhonePlan{
+	"AE": {CallingCode: "971", TrunkPrefix: "0", MinLength: 8, MaxLength: 9, MobilePrefixes: []string{"5"}},
+	"AR": {CallingCode: "54", TrunkPrefix: "0", MinLength: 10, MaxLength: 10},
+	"AT": {CallingCode: "43", TrunkPrefix: "0", MinLength: 4, MaxLength: 13, MobilePrefixes: []string{"6"}},
+	"AU": {CallingCode: "61", TrunkPrefix: "0", MinLength: 9, MaxLength: 9, MobilePrefixes: []string{"4"}},
+	"BE": {CallingCode: "32", TrunkPrefix: "0", MinLength: 8, MaxLength: 9, MobilePrefixes: []string{"4"}},
+	"BR": {CallingCode: "55", TrunkPrefix: "0", MinLength: 10, MaxLength: 11},
+	"CA": {CallingCode: "1", TrunkPrefix: "1", MinLength: 10, MaxLength: 10, LeadingDigits: []string{
+		"204", "226", "236", "249", "250", "263", "289", "306", "343", "354", "365", "367", "368", "382",
+		"387", "403", "416", "418", "428", "431", "437", "438", "450", "460", "468", "474", "506", "514",
+		"519", "548", "579", "581", "584", "587", "604", "613", "639", "647", "672", "683", "705", "709",
+		"742", "753", "778", "780", "782", "807", "819", "825", "867", "873", "879", "902", "905", "942",
+	}},
+	"CH": {CallingCode: "41", TrunkPrefix: "0", MinLength: 9, MaxLength: 9, MobilePrefixes: []string{"7"}},
+	"CN": {CallingCode: "86", TrunkPrefix: "0", MinLength: 9, MaxLength: 11, MobilePrefixes: []string{"1"}},
+	"CZ": {CallingCode: "420", MinLength: 9, MaxLength: 9, MobilePrefixes: []string{"6", "7"}},
+	"DE": {CallingCode: "49", TrunkPrefix: "0", MinLength: 6, MaxLength: 13, MobilePrefixes: []string{"15", "16", "17"}},
+	"DK": {CallingCode: "45", MinLength: 8, MaxLength: 8},
+	"EG": {CallingCode: "20", TrunkPrefix: "0", MinLength: 8, MaxLength: 10, MobilePrefixes: []string{"1"}},
+	"ES": {CallingCode: "34", MinLength: 9, MaxLength: 9, MobilePrefixes: []string{"6", "7"}},
+	"FI": {CallingCode: "358", TrunkPrefix: "0", MinLength: 5, MaxLength: 12, MobilePrefixes: []string{"4", "50"}},
+	"FR": {CallingCode: "33", TrunkPrefix: "0", MinLength: 9, MaxLength: 9, MobilePrefixes: []string{"6", "7"}},
+	"GB": {CallingCode: "44", TrunkPrefix: "0", MinLength: 9, MaxLength: 10, MobilePrefixes: []string{"7"}},
+	"GR": {CallingCode: "30", MinLength: 10, MaxLength: 10, MobilePrefixes: []string{"69"}},
+	"HK": {CallingCode: "852", MinLength: 8, MaxLength: 8, MobilePrefixes: []string{"5", "6", "9"}},
+	"IE": {CallingCode: "353", TrunkPrefix: "0", MinLength: 7, MaxLength: 9, MobilePrefixes: []string{"8"}},
+	"IL": {CallingCode: "972", TrunkPrefix: "0", MinLength: 8, MaxLength: 9, MobilePrefixes: []string{"5"}},
+	"IN": {CallingCode: "91", TrunkPrefix: "0", MinLength: 10, MaxLength: 10, MobilePrefixes: []string{"6", "7", "8", "9"}},
+	"IT": {CallingCode: "39", MinLength: 6, MaxLength: 11, MobilePrefixes: []string{"3"}},
+	"JP": {CallingCode: "81", TrunkPrefix: "0", MinLength: 9, MaxLength: 10, MobilePrefixes: []string{"70", "80", "90"}},
+	"KR": {CallingCode: "82", TrunkPrefix: "0", MinLength: 8, MaxLength: 10, MobilePrefixes: []string{"10"}},
+	"KZ": {CallingCode: "7", TrunkPrefix: "8", MinLength: 10, MaxLength: 10, MobilePrefixes: []string{"70", "77"}, LeadingDigits: []string{"6", "7"}},
+	"MA": {CallingCode: "212", TrunkPrefix: "0", MinLength: 9, MaxLength: 9, MobilePrefixes: []string{"6", "7"}},
+	"MX": {CallingCode: "52", MinLength: 10, MaxLength: 10},
+	"NG": {CallingCode: "234", TrunkPrefix: "0", MinLength: 8, MaxLength: 10, MobilePrefixes: []string{"7", "8", "9"}},
+	"NL": {CallingCode: "31", TrunkPrefix: "0", MinLength: 9, MaxLength: 9, MobilePrefixes: []string{"6"}},
+	"NO": {CallingCode: "47", MinLength: 8, MaxLength: 8, MobilePrefixes: []string{"4", "9"}},
+	"NZ": {CallingCode: "64", TrunkPrefix: "0", MinLength: 8, MaxLength: 10, MobilePrefixes: []string{"2"}},
+	"PL": {CallingCode: "48", MinLength: 9, MaxLength: 9, MobilePrefixes: []string{"45", "5", "6", "7", "8"}},
+	"PT": {CallingCode: "351", MinLength: 9, MaxLength: 9, MobilePrefixes: []string{"9"}},
+	"RU": {CallingCode: "7", TrunkPrefix: "8", MinLength: 10, MaxLength: 10, MobilePrefixes: []string{"9"}},
+	"SA": {CallingCode: "966", TrunkPrefix: "0", MinLength: 9, MaxLength: 9, MobilePrefixes: []string{"5"}},
+	"SE": {CallingCode: "46", TrunkPrefix: "0", MinLength: 7, MaxLength: 10, MobilePrefixes: []string{"7"}},
+	"SG": {CallingCode: "65", MinLength: 8, MaxLength: 8, MobilePrefixes: []string{"8", "9"}},
+	"TR": {CallingCode: "90", TrunkPrefix: "0", MinLength: 10, MaxLength: 10, MobilePrefixes: []string{"5"}},
+	"UA": {CallingCode: "380", TrunkPrefix: "0", MinLength: 9, MaxLength: 9},
+	"US": {CallingCode: "1", TrunkPrefix: "1", MinLength: 10, MaxLength: 10},
+	"ZA": {CallingCode: "27", TrunkPrefix: "0", MinLength: 9, MaxLength: 9, MobilePrefixes: []string{"6", "7", "8"}},
+}
+
+// callingCodeRegions maps the ITU country calling codes to their main region,
+// or "" for non-geographic codes. International numbers whose calling code
+// has no plan in PhonePlans are only checked against the E.164 length limits.
+var callingCodeRegions = map[string]string{
+	"1": "US", "7": "RU", "20": "EG", "27": "ZA", "30": "GR", "31": "NL", "32": "BE", "33": "FR",
+	"34": "ES", "36": "HU", "39": "IT", "40": "RO", "41": "CH", "43": "AT", "44": "GB", "45": "DK",
+	"46": "SE", "47": "NO", "48": "PL", "49": "DE", "51": "PE", "52": "MX", "53": "CU", "54": "AR",
+	"55": "BR", "56": "CL", "57": "CO", "58": "VE", "60": "MY", "61": "AU", "62": "ID", "63": "PH",
+	"64": "NZ", "65": "SG", "66": "TH", "81": "JP", "82": "KR", "84": "VN", "86": "CN", "90": "TR",
+	"91": "IN", "92": "PK", "93": "AF", "94": "LK", "95": "MM", "98": "IR",
+	"211": "SS", "212": "MA", "213": "DZ", "216": "TN", "218": "LY", "220": "GM", "221": "SN",
+	"222": "MR", "223": "ML", "224": "GN", "225": "CI", "226": "BF", "227": "NE", "228": "TG",
+	"229": "BJ", "230": "MU", "231": "LR", "232": "SL", "233": "GH", "234": "NG", "235": "TD",
+	"236": "CF", "237": "CM", "238": "CV", "239": "ST", "240": "GQ", "241": "GA", "242": "CG",
+	"243": "CD", "244": "AO", "245": "GW", "246": "IO", "247": "AC", "248": "SC", "249": "SD",
+	"250": "RW", "251": "ET", "252": "SO", "253": "DJ", "254": "KE", "255": "TZ", "256": "UG",
+	"257": "BI", "258": "MZ", "260": "ZM", "261": "MG", "262": "RE", "263": "ZW", "264": "NA",
+	"265": "MW", "266": "LS", "267": "BW", "268": "SZ", "269": "KM", "290": "SH", "291": "ER",
+	"297": "AW", "298": "FO", "299": "GL",
+	"350": "GI", "351": "PT", "352": "LU", "353": "IE", "354": "IS", "355": "AL", "356": "MT",
+	"357": "CY", "358": "FI", "359": "BG", "370": "LT", "371": "LV", "372": "EE", "373": "MD",
+	"374": "AM", "375": "BY", "376": "AD", "377": "MC", "378": "SM", "380": "UA", "381": "RS",
+	"382": "ME", "383": "XK", "385": "HR", "386": "SI", "387": "BA", "389": "MK",
+	"420": "CZ", "421": "SK", "423": "LI",
+	"500": "FK", "501": "BZ", "502": "GT", "503": "SV", "504": "HN", "505": "NI", "506": "CR",
+	"507": "PA", "508": "PM", "509": "HT", "590": "GP", "591": "BO", "592": "GY", "593": "EC",
+	"594": "GF", "595": "PY", "596": "MQ", "597": "SR", "598": "UY", "599": "CW",
+	"670": "TL", "672": "NF", "673": "BN", "674": "NR", "675": "PG", "676": "TO", "677": "SB",
+	"678": "VU", "679": "FJ", "680": "PW", "681": "WF", "682": "CK", "683": "NU", "685": "WS",
+	"686": "KI", "687": "NC", "688": "TV", "689": "PF", "690": "TK", "691": "FM", "692": "MH",
+	"800": "", "808": "", "850": "KP", "852": "HK", "853": "MO", "855": "KH", "856": "LA",
+	"870": "", "878": "", "880": "BD", "881": "", "882": "", "883": "", "886": "TW", "888": "",
+	"960": "MV", "961": "LB", "962": "JO", "963": "SY", "964": "IQ", "965": "KW", "966": "SA",
+	"967": "YE", "968": "OM", "970": "PS", "971": "AE", "972": "IL", "973": "BH", "974": "QA",
+	"975": "BT", "976": "MN", "977": "NP", "979": "", "992": "TJ", "993": "TM", "994": "AZ",
+	"995": "GE", "996": "KG", "998": "UZ",
+}
@@ -0,0 +1,77 @@
+package is
+
+// PostalCodePatterns maps ISO 3166-1 alpha-2 country codes to the regular
+// expression a postal code of that country must match (case-insensitively).
+// An empty pattern marks a country without postal codes, where any value is
+// accepted. It is used by PostalCode and may be modified at init time to add
+// countries or override patterns.
+var PostalCodePatterns = map[string]string{
+	"AR": `^[A-HJ-NP-Z]?\d{4}(?:[A-Z]{3})?$`,
+	"AT": `^\d{4}$`,
+	"AU": `^\d{4}$`,
+	"BE": `^\d{4}$`,
+	"BG": `^\d{4}$`,
+	"BR": `^\d{5}-?\d{3}$`,
+	"CA": `^[ABCEGHJ-NPRSTVXY]\d[ABCEGHJ-NPRSTV-Z] ?\d[ABCEGHJ-NPRSTV-Z]\d$`,
+	"CH": `^\d{4}$`,
+	"CL": `^\d{7}$`,
+	"CN": `^\d{6}$`,
+	"CO": `^\d{6}$`,
+	"CY": `^\d{4}$`,
+	"CZ": `^\d{3} ?\d{2}$`,
+	"DE": `^\d{5}$`,
+	"DK": `^\d{4}$`,
+	"EE": `^\d{5}$`,
+	"EG": `^\d{5}$`,
+	"ES": `^\d{5}$`,
+	"FI": `^\d{5}$`,
+	"FR": `^\d{5}$`,
+	"GB": `^(?:GIR ?0AA|[A-Z]{1,2}\d[A-Z\d]? ?\d[A-Z]{2})$`,
+	"GR": `^\d{3} ?\d{2}$`,
+	"HR": `^\d{5}$`,
+	"HU": `^\d{4}$`,
+	"ID": `^\d{5}$`,
+	"IE": `^(?:[AC-FHKNPRTV-Y]\d{2}|D6W) ?[0-9AC-FHKNPRTV-Y]{4}$`,
+	"IL": `^\d{7}$`,
+	"IN": `^\d{6}$`,
+	"IS": `^\d{3}$`,
+	"IT": `^\d{5}$`,
+	"JP": `^\d{3}-?\d{4}$`,
+	"KR": `^\d{5}$`,
+	"LT": `^(?:LT-)?\d{5}$`,
+	"LU": `^(?:L-)?\d{4}$`,
+	"LV": `^(?:LV-)?\d{4}$`,
+	"MA": `^\d{5}$`,
+	"MT": `^[A-Z]{3} ?\d{2,4}$`,
+	"MX": `^\d{5}$`,
+	"MY": `^\d{5}$`,
+	"NL": `^\d{4} ?[A-Z]{2}$`,
+	"NO": `^\d{4}$`,
+	"NZ": `^\d{4}$`,
+	"PE": `^\d{5}$`,
+	"PH": `^\d{4}$`,
+	"PK": `^\d{5}$`,
+	"PL": `^\d{2}-\d{3}$`,
+	"PT": `^\d{4}-\d{3}$`,
+	"RO": `^\d{6}$`,
+	"RS": `^\d{5}$`,
+	"RU": `^\d{6}$`,
+	"SA": `^\d{5}(?:-\d{4})?$`,
+	"SE": `^\d{3} ?\d{2}$`,
+	"SG": `^\d{6}$`,
+	"SI": `^\d{4}$`,
+	"SK": `^\d{3} ?\d{2}$`,
+	"TH": `^\d{5}$`,
+	"TR": `^\d{5}$`,
+	"TW": `^\d{3}(?:\d{2,3})?$`,
+	"UA": `^\d{5}$`,
+	"US": `^\d{5}(?:-\d{4})?$`,
+	"VN": `^\d{6}$`,
+	"ZA": `^\d{4}$`,
+	"AE": "",
+	"AO": "",
+	"BS": "",
+	"FJ": "",
+	"HK": "",
+	"QA": "",
+}
@@ -8,6 +8,9 @@ type (
 	Violation struct {
 		Code    ViolationCode
 		Message string
+		// Params holds rule-specific details about the violation (e.g. the
+		// offending character). It may be nil.
+		Params map[string]any
 	}
 
 	ViolationCode string
@@ -42,6 +45,89 @@ const (
 	ViolationMinLength ViolationCode = "VALIDATION_MIN_LENGTH"
 	ViolationMaxLength ViolationCode = "VALIDATION_MAX_LENGTH"
 	ViolationNotEmpty  ViolationCode = "VALIDATION_NOT_EMPTY"
+
+	ViolationMaxDecimals ViolationCode = "VALIDATION_MAX_DECIMALS"
+	ViolationMultipleOf  ViolationCode = "VALIDATION_MULTIPLE_OF"
+	ViolationMaxDigits   ViolationCode = "VALIDATION_MAX_DIGITS"
+	ViolationFinite      ViolationCode = "VALIDATION_FINITE"
+	ViolationInteger     ViolationCode = "VALIDATION_INTEGER"
+	ViolationUTF8        ViolationCode = "VALIDATION_UTF8"
+
+	ViolationLetters          ViolationCode = "VALIDATION_LETTERS"
+	ViolationLettersDigits    ViolationCode = "VALIDATION_LETTERS_AND_DIGITS"
+	ViolationPrintable        ViolationCode = "VALIDATION_PRINTABLE"
+	ViolationControlChars     ViolationCode = "VALIDATION_NO_CONTROL_CHARS"
+	ViolationASCII            ViolationCode = "VALIDATION_ASCII"
+	ViolationLowercase        ViolationCode = "VALIDATION_LOWERCASE"
+	ViolationUppercase        ViolationCode = "VALIDATION_UPPERCASE"
+	ViolationSurroundingSpace ViolationCode = "VALIDATION_NO_LEADING_TRAILING_SPACE"
+	ViolationCharset          ViolationCode = "VALIDATION_CHARSET"
+
+	ViolationUUIDVersion ViolationCode = "VALIDATION_UUID_VERSION"
+	ViolationNilUUID     ViolationCode = "VALIDATION_NOT_NIL_UUID"
+	ViolationULID        ViolationCode = "VALIDATION_ULID"
+	ViolationKSUID       ViolationCode = "VALIDATION_KSUID"
+	ViolationObjectID    ViolationCode = "VALIDATION_OBJECT_ID"
+	ViolationSnowflake   ViolationCode = "VALIDATION_SNOWFLAKE"
+	ViolationNanoID      ViolationCode = "VALIDATION_NANOID"
+
+	ViolationBase64        ViolationCode = "VALIDATION_BASE64"
+	ViolationBase32        ViolationCode = "VALIDATION_BASE32"
+	ViolationHex           ViolationCode = "VALIDATION_HEX"
+	ViolationHexBytes      ViolationCode = "VALIDATION_HEX_BYTES"
+	ViolationJSON          ViolationCode = "VALIDATION_JSON"
+	ViolationJSONObject    ViolationCode = "VALIDATION_JSON_OBJECT"
+	ViolationJWT           ViolationCode = "VALIDATION_JWT"
+	ViolationJWTAlg        ViolationCode = "VALIDATION_JWT_ALG"
+	ViolationDecodedLength ViolationCode = "VALIDATION_DECODED_LENGTH"
+
+	ViolationLuhn           ViolationCode = "VALIDATION_LUHN"
+	ViolationCreditCard     ViolationCode = "VALIDATION_CREDIT_CARD"
+	ViolationCardBrand      ViolationCode = "VALIDATION_CARD_BRAND"
+	ViolationIBAN           ViolationCode = "VALIDATION_IBAN"
+	ViolationBIC            ViolationCode = "VALIDATION_BIC"
+	ViolationCurrency       ViolationCode = "VALIDATION_CURRENCY"
+	ViolationCurrencyAmount ViolationCode = "VALIDATION_CURRENCY_AMOUNT"
+
+	ViolationCountry    ViolationCode = "VALIDATION_COUNTRY"
+	ViolationLanguage   ViolationCode = "VALIDATION_LANGUAGE"
+	ViolationBCP47      ViolationCode = "VALIDATION_BCP47"
+	ViolationLatitude   ViolationCode = "VALIDATION_LATITUDE"
+	ViolationLongitude  ViolationCode = "VALIDATION_LONGITUDE"
+	ViolationPostalCode ViolationCode = "VALIDATION_POSTAL_CODE"
+
+	ViolationE164  ViolationCode = "VALIDATION_E164"
+	ViolationPhone ViolationCode = "VALIDATION_PHONE"
+
+	ViolationPassword              ViolationCode = "VALIDATION_PASSWORD"
+	ViolationPasswordTooShort      ViolationCode = "VALIDATION_PASSWORD_TOO_SHORT"
+	ViolationPasswordTooLong       ViolationCode = "VALIDATION_PASSWORD_TOO_LONG"
+	ViolationPasswordLower         ViolationCode = "VALIDATION_PASSWORD_LOWERCASE"
+	ViolationPasswordUpper         ViolationCode = "VALIDATION_PASSWORD_UPPERCASE"
+	ViolationPasswordDigit         ViolationCode = "VALIDATION_PASSWORD_DIGIT"
+	ViolationPasswordSymbol        ViolationCode = "VALIDATION_PASSWORD_SYMBOL"
+	ViolationPasswordRepeated      ViolationCode = "VALIDATION_PASSWORD_REPEATED"
+	ViolationPasswordContainsField ViolationCode = "VALIDATION_PASSWORD_CONTAINS_FIELD"
+	ViolationPasswordBanned        ViolationCode = "VALIDATION_PASSWORD_BANNED"
+	ViolationPasswordEntropy       ViolationCode = "VALIDATION_PASSWORD_ENTROPY"
+
+	ViolationUnique       ViolationCode = "VALIDATION_UNIQUE"
+	ViolationSorted       ViolationCode = "VALIDATION_SORTED"
+	ViolationSubsetOf     ViolationCode = "VALIDATION_SUBSET_OF"
+	ViolationContainsAll  ViolationCode = "VALIDATION_CONTAINS_ALL"
+	ViolationContainsNone ViolationCode = "VALIDATION_CONTAINS_NONE"
+	ViolationMinItems     ViolationCode = "VALIDATION_MIN_ITEMS"
+	ViolationMaxItems     ViolationCode = "VALIDATION_MAX_ITEMS"
+
+	ViolationEnum ViolationCode = "VALIDATION_ENUM"
+
+	ViolationTime ViolationCode = "VALIDATION_TIME"
+	ViolationIP   ViolationCode = "VALIDATION_IP"
+
+	ViolationType         ViolationCode = "VALIDATION_TYPE"
+	ViolationUnknownField ViolationCode = "VALIDATION_UNKNOWN_FIELD"
+	ViolationBodyTooLarge ViolationCode = "VALIDATION_BODY_TOO_LARGE"
+	ViolationForm         ViolationCode = "VALIDATION_FORM"
 )
 
 var Messages = map[ViolationCode]string{
@@ -71,4 +157,87 @@ var Messages = map[ViolationCode]string{
 	ViolationMinLength: "length must be >= {min}",
 	ViolationMaxLength: "length must be <= {max}",
 	ViolationNotEmpty:  "must not be empty",
+
+	ViolationMaxDecimals: "must have at most {max} decimal places",
+	ViolationMultipleOf:  "must be a multiple of {step}",
+	ViolationMaxDigits:   "must have at most {total} digits, {fraction} of them after the decimal point",
+	ViolationFinite:      "must be a finite number",
+	ViolationInteger:     "must be an integer",
+	ViolationUTF8:        "must be valid UTF-8",
+
+	ViolationLetters:          "must contain only letters",
+	ViolationLettersDigits:    "must contain only letters and digits",
+	ViolationPrintable:        "must contain only printable characters",
+	ViolationControlChars:     "must not contain control characters",
+	ViolationASCII:            "must contain only ASCII characters",
+	ViolationLowercase:        "must be lowercase",
+	ViolationUppercase:        "must be uppercase",
+	ViolationSurroundingSpace: "must not start or end with white space",
+	ViolationCharset:          "contains a character that is not allowed",
+
+	ViolationUUIDVersion: "must be a UUID of version {versions}",
+	ViolationNilUUID:     "must not be the nil UUID",
+	ViolationULID:        "must be a valid ULID",
+	ViolationKSUID:       "must be a valid KSUID",
+	ViolationObjectID:    "must be a valid ObjectID",
+	ViolationSnowflake:   "must be a valid snowflake ID",
+	ViolationNanoID:      "must be a valid {length}-character ID",
+
+	ViolationBase64:        "must be valid base64",
+	ViolationBase32:        "must be valid base32",
+	ViolationHex:           "must be valid hexadecimal",
+	ViolationHexBytes:      "must be {bytes} hex-encoded bytes",
+	ViolationJSON:          "must be valid JSON",
+	ViolationJSONObject:    "must be a JSON object",
+	ViolationJWT:           "must be a well-formed JWT",
+	ViolationJWTAlg:        "JWT algorithm must be one of {algs}",
+	ViolationDecodedLength: "decoded length must be between {min} and {max} bytes",
+
+	ViolationLuhn:           "must have a valid checksum",
+	ViolationCreditCard:     "must be a valid card number",
+	ViolationCardBrand:      "card brand must be one of {brands}",
+	ViolationIBAN:           "must be a valid IBAN",
+	ViolationBIC:            "must be a valid BIC",
+	ViolationCurrency:       "must be a valid ISO 4217 currency code",
+	ViolationCurrencyAmount: "must have at most {decimals} decimal places for {currency}",
+
+	ViolationCountry:    "must be a valid country code",
+	ViolationLanguage:   "must be a valid language code",
+	ViolationBCP47:      "must be a valid language tag",
+	ViolationLatitude:   "must be a latitude between -90 and 90",
+	ViolationLongitude:  "must be a longitude between -180 and 180",
+	ViolationPostalCode: "must be a valid postal code for {country}",
+
+	ViolationE164:  "must be a phone number in E.164 format",
+	ViolationPhone: "must be a valid phone number",
+
+	ViolationPassword:              "must be a valid password",
+	ViolationPasswordTooShort:      "must be at least {min} characters long",
+	ViolationPasswordTooLong:       "must be at most {max} characters long",
+	ViolationPasswordLower:         "must contain a lowercase letter",
+	ViolationPasswordUpper:         "must contain an uppercase letter",
+	ViolationPasswordDigit:         "must contain a digit",
+	ViolationPasswordSymbol:        "must contain a symbol",
+	ViolationPasswordRepeated:      "must not repeat a character more than {max} times in a row",
+	ViolationPasswordContainsField: "must not contain your {field}",
+	ViolationPasswordBanned:        "is too common",
+	ViolationPasswordEntropy:       "is too easy to guess",
+
+	ViolationUnique:       "must be unique",
+	ViolationSorted:       "must be sorted",
+	ViolationSubsetOf:     "must only contain {values}",
+	ViolationContainsAll:  "must contain {missing}",
+	ViolationContainsNone: "must not contain {value}",
+	ViolationMinItems:     "must contain at least {min} items",
+	ViolationMaxItems:     "must contain at most {max} items",
+
+	ViolationEnum: "must be a valid {type}",
+
+	ViolationTime: "must be a time in format {layout}",
+	ViolationIP:   "must be a valid IP address",
+
+	ViolationType:         "must be of type {type}",
+	ViolationUnknownField: "is not allowed",
+	ViolationBodyTooLarge: "must not exceed {limit} bytes",
+	ViolationForm:         "must be a valid form body",
 }
@@ -1 +1,16 @@
 package is
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatMessage(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "must be between 1 and 10", FormatMessage(ViolationBetween, map[string]any{"min": 1, "max": 10}))
+	require.Equal(t, "is required", FormatMessage(ViolationRequired, nil))
+	require.Equal(t, "must be between {min} and 10", FormatMessage(ViolationBetween, map[string]any{"max": 10}))
+	require.Equal(t, "invalid value", FormatMessage("UNKNOWN", nil))
+}
@@ -0,0 +1,38 @@
+package is
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// Parser validates a raw value and converts it to a typed value. On failure
+// it returns a non-nil *Violation, like a Rule, and the zero T.
+//
+// Parsers do not handle optional values themselves: valid.Parse and
+// Parser.Rule skip None and unwrap Some before calling them.
+type Parser[T any] func(ctx context.Context, value any) (T, *Violation)
+
+// Rule returns a Rule that parses value with p and evaluates rules against the
+// parsed T, discarding it. Use valid.Parse to keep the parsed value.
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> parses and validates the unwrapped value.
+//
+// The RuleSpec of the returned Rule is named "Parse", with the parsed type in
+// Params["type"] and rules in Rules.
+func (p Parser[T]) Rule(rules ...Rule) Rule {
+	spec := RuleSpec{Name: "Parse", Params: map[string]any{"type": reflect.TypeFor[T]()}, Rules: DescribeRules(rules)}
+	return WithSpec(spec, func(ctx context.Context, value any) *Violation {
+		resolved, skip := ishelper.ExtractOptional(value)
+		if skip {
+			return nil
+		}
+		parsed, v := p(ctx, resolved)
+		if v != nil {
+			return v
+		}
+		return applyRules(ctx, parsed, rules)
+	})
+}
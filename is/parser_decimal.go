@@ -0,0 +1,28 @@
+package is
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// DecimalParser is a Parser that converts a decimal string, read exactly as
+// by ParsedNumber, or any numeric value supported by ishelper.ToDecimalRat to
+// a *big.Rat.
+//
+// Accepted types: string in decimal notation, e.g. "42", "-4.50", "1e3";
+// numeric types.
+// Unsupported types and unparsable text produce ViolationNumeric.
+var DecimalParser Parser[*big.Rat] = func(_ context.Context, value any) (*big.Rat, *Violation) {
+	if s, ok := value.(string); ok {
+		if decimalRegex.MatchString(s) {
+			if r, ok := new(big.Rat).SetString(s); ok {
+				return r, nil
+			}
+		}
+	} else if r, ok := ishelper.ToDecimalRat(value); ok {
+		return r, nil
+	}
+	return nil, &Violation{Code: ViolationNumeric, Message: FormatMessage(ViolationNumeric, nil)}
+}
@@ -0,0 +1,29 @@
+package is
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecimalParser(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r, v := DecimalParser(ctx, "-4.50")
+	require.Nil(t, v)
+	require.Equal(t, big.NewRat(-9, 2), r)
+	r, v = DecimalParser(ctx, 0.1)
+	require.Nil(t, v)
+	require.Equal(t, big.NewRat(1, 10), r)
+
+	r, v = DecimalParser(ctx, "1/3")
+	require.Equal(t, ViolationNumeric, v.Code)
+	require.Nil(t, r)
+	_, v = DecimalParser(ctx, "0x10")
+	require.Equal(t, ViolationNumeric, v.Code)
+	_, v = DecimalParser(ctx, true)
+	require.Equal(t, ViolationNumeric, v.Code)
+}
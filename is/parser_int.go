@@ -0,0 +1,21 @@
+package is
+
+import (
+	"context"
+	"strconv"
+)
+
+// IntParser is a Parser that converts a base-10 integer string ("42", "-7")
+// to an int64.
+//
+// Accepted type: string.
+// Unsupported types, non-integer text and values outside the int64 range
+// produce ViolationInteger.
+var IntParser Parser[int64] = func(_ context.Context, value any) (int64, *Violation) {
+	if s, ok := value.(string); ok {
+		if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return n, nil
+		}
+	}
+	return 0, &Violation{Code: ViolationInteger, Message: FormatMessage(ViolationInteger, nil)}
+}
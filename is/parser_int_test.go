@@ -0,0 +1,27 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntParser(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	n, v := IntParser(ctx, "-42")
+	require.Nil(t, v)
+	require.Equal(t, int64(-42), n)
+
+	n, v = IntParser(ctx, "9223372036854775808")
+	require.Equal(t, ViolationInteger, v.Code)
+	require.Zero(t, n)
+	_, v = IntParser(ctx, "1.0")
+	require.Equal(t, ViolationInteger, v.Code)
+	_, v = IntParser(ctx, " 1")
+	require.Equal(t, ViolationInteger, v.Code)
+	_, v = IntParser(ctx, 1)
+	require.Equal(t, ViolationInteger, v.Code)
+}
@@ -0,0 +1,20 @@
+package is
+
+import (
+	"context"
+	"net/netip"
+)
+
+// IPParser is a Parser that converts an IPv4 or IPv6 address
+// ("192.0.2.1", "2001:db8::1", "fe80::1%eth0") to a netip.Addr.
+//
+// Accepted type: string.
+// Unsupported types and malformed addresses produce ViolationIP.
+var IPParser Parser[netip.Addr] = func(_ context.Context, value any) (netip.Addr, *Violation) {
+	if s, ok := value.(string); ok {
+		if addr, err := netip.ParseAddr(s); err == nil {
+			return addr, nil
+		}
+	}
+	return netip.Addr{}, &Violation{Code: ViolationIP, Message: FormatMessage(ViolationIP, nil)}
+}
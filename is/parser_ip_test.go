@@ -0,0 +1,29 @@
+package is
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIPParser(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	addr, v := IPParser(ctx, "192.0.2.1")
+	require.Nil(t, v)
+	require.Equal(t, netip.MustParseAddr("192.0.2.1"), addr)
+	addr, v = IPParser(ctx, "2001:db8::1")
+	require.Nil(t, v)
+	require.True(t, addr.Is6())
+
+	addr, v = IPParser(ctx, "192.0.2.256")
+	require.Equal(t, ViolationIP, v.Code)
+	require.False(t, addr.IsValid())
+	_, v = IPParser(ctx, "192.0.2.1/24")
+	require.Equal(t, ViolationIP, v.Code)
+	_, v = IPParser(ctx, []byte{192, 0, 2, 1})
+	require.Equal(t, ViolationIP, v.Code)
+}
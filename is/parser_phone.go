@@ -0,0 +1,24 @@
+package is
+
+import "context"
+
+// PhoneParser returns a Parser that converts a phone number to a Phone,
+// accepting the same values as PhoneNumber(defaultRegion). Store Phone.E164.
+//
+// Accepted type: string.
+// Unsupported types, unknown calling codes and wrong lengths produce
+// ViolationPhone.
+// Violation params: "region" (the detected region, or defaultRegion).
+// A non-empty defaultRegion missing from PhonePlans panics at construction
+// time.
+func PhoneParser(defaultRegion string) Parser[Phone] {
+	rule := PhoneNumber(defaultRegion)
+	return func(ctx context.Context, value any) (Phone, *Violation) {
+		if s, ok := value.(string); ok {
+			if phone, ok := ParsePhone(s, defaultRegion); ok {
+				return phone, nil
+			}
+		}
+		return Phone{}, rule(ctx, value)
+	}
+}
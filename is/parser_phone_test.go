@@ -0,0 +1,26 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPhoneParser(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	parse := PhoneParser("FR")
+	phone, v := parse(ctx, "06 12 34 56 78")
+	require.Nil(t, v)
+	require.Equal(t, "+33612345678", phone.E164)
+
+	phone, v = parse(ctx, "06 12")
+	require.Equal(t, ViolationPhone, v.Code)
+	require.Equal(t, map[string]any{"region": "FR"}, v.Params)
+	require.Equal(t, Phone{}, phone)
+	_, v = parse(ctx, 612345678)
+	require.Equal(t, ViolationPhone, v.Code)
+	require.Panics(t, func() { PhoneParser("XX") })
+}
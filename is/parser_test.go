@@ -0,0 +1,23 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParserRule(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rule := IntParser.Rule(Between(1, 100))
+	require.Nil(t, rule(ctx, "42"))
+	require.Equal(t, ViolationInteger, rule(ctx, "4.2").Code)
+	require.Equal(t, ViolationBetween, rule(ctx, "420").Code)
+	require.Nil(t, IntParser.Rule()(ctx, "-1"))
+	require.Nil(t, rule(ctx, ishelper.None[string]()))
+	require.Nil(t, rule(ctx, ishelper.Some("7")))
+	require.Equal(t, ViolationBetween, rule(ctx, ishelper.Some("0")).Code)
+}
@@ -0,0 +1,24 @@
+package is
+
+import (
+	"context"
+	"time"
+)
+
+// TimeParser returns a Parser that converts text in the given layout (e.g.
+// time.RFC3339 or time.DateOnly) to a time.Time with time.Parse.
+//
+// Accepted type: string.
+// Unsupported types and text not matching layout produce ViolationTime.
+// Violation params: "layout".
+func TimeParser(layout string) Parser[time.Time] {
+	return func(_ context.Context, value any) (time.Time, *Violation) {
+		if s, ok := value.(string); ok {
+			if t, err := time.Parse(layout, s); err == nil {
+				return t, nil
+			}
+		}
+		params := map[string]any{"layout": layout}
+		return time.Time{}, &Violation{Code: ViolationTime, Message: FormatMessage(ViolationTime, params), Params: params}
+	}
+}
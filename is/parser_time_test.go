@@ -0,0 +1,32 @@
+package is
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeParser(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	parse := TimeParser(time.DateOnly)
+	got, v := parse(ctx, "2024-02-29")
+	require.Nil(t, v)
+	require.Equal(t, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), got)
+
+	got, v = parse(ctx, "2023-02-29")
+	require.Equal(t, ViolationTime, v.Code)
+	require.Equal(t, "must be a time in format 2006-01-02", v.Message)
+	require.Equal(t, map[string]any{"layout": time.DateOnly}, v.Params)
+	v.Params["layout"] = "" // violations do not share their params
+	_, v = parse(ctx, "2023-02-29")
+	require.Equal(t, map[string]any{"layout": time.DateOnly}, v.Params)
+	require.True(t, got.IsZero())
+	_, v = TimeParser(time.RFC3339)(ctx, "2024-02-29")
+	require.Equal(t, ViolationTime, v.Code)
+	_, v = parse(ctx, time.Now())
+	require.Equal(t, ViolationTime, v.Code)
+}
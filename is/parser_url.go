@@ -0,0 +1,21 @@
+package is
+
+import (
+	"context"
+	"net/url"
+)
+
+// URLParser is a Parser that converts an absolute URL string to a *url.URL,
+// accepting the same values as URL.
+//
+// Accepted type: string.
+// Unsupported types, relative URLs, and malformed URL text produce
+// ViolationURL.
+var URLParser Parser[*url.URL] = func(_ context.Context, value any) (*url.URL, *Violation) {
+	if s, ok := value.(string); ok {
+		if u, err := url.ParseRequestURI(s); err == nil && u.Scheme != "" && u.Host != "" {
+			return u, nil
+		}
+	}
+	return nil, &Violation{Code: ViolationURL, Message: FormatMessage(ViolationURL, nil)}
+}
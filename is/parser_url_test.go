@@ -0,0 +1,24 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestURLParser(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	u, v := URLParser(ctx, "https://example.com/a?b=c")
+	require.Nil(t, v)
+	require.Equal(t, "example.com", u.Host)
+	require.Equal(t, "/a", u.Path)
+
+	u, v = URLParser(ctx, "/relative")
+	require.Equal(t, ViolationURL, v.Code)
+	require.Nil(t, u)
+	_, v = URLParser(ctx, 1)
+	require.Equal(t, ViolationURL, v.Code)
+}
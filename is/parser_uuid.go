@@ -0,0 +1,24 @@
+package is
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+)
+
+// UUIDParser is a Parser that converts UUID text
+// ("6ba7b810-9dad-11d1-80b4-00c04fd430c8", any case) to its 16 bytes.
+//
+// Accepted type: string.
+// Unsupported types and non-matching text produce ViolationUUID.
+var UUIDParser Parser[[16]byte] = func(_ context.Context, value any) ([16]byte, *Violation) {
+	var id [16]byte
+	s, ok := value.(string)
+	if !ok || !uuidRegex.MatchString(s) {
+		return id, &Violation{Code: ViolationUUID, Message: FormatMessage(ViolationUUID, nil)}
+	}
+	if _, err := hex.Decode(id[:], []byte(strings.ReplaceAll(s, "-", ""))); err != nil {
+		return id, &Violation{Code: ViolationUUID, Message: FormatMessage(ViolationUUID, nil)}
+	}
+	return id, nil
+}
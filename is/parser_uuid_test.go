@@ -0,0 +1,23 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUUIDParser(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	id, v := UUIDParser(ctx, "6BA7B810-9dad-11d1-80b4-00c04fd430c8")
+	require.Nil(t, v)
+	require.Equal(t, [16]byte{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}, id)
+
+	id, v = UUIDParser(ctx, "6ba7b810-9dad-11d1-80b4-00c04fd430c")
+	require.Equal(t, ViolationUUID, v.Code)
+	require.Equal(t, [16]byte{}, id)
+	_, v = UUIDParser(ctx, 42)
+	require.Equal(t, ViolationUUID, v.Code)
+}
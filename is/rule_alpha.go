@@ -15,14 +15,19 @@ var alphaRegex = regexp.MustCompile(`^[a-zA-Z]+$`)
 //
 // Optional behavior: None -> nil (absent field skips the constraint);
 // Some(v) -> validates the unwrapped value.
-var Alpha Rule = func(_ context.Context, value any) *Violation {
+var Alpha Rule = WithSpec(RuleSpec{
+	Name:   "Alpha",
+	Code:   ViolationAlpha,
+	Params: map[string]any{"pattern": alphaRegex.String()},
+	Kinds:  []Kind{KindString},
+}, func(_ context.Context, value any) *Violation {
 	resolved, skip := ishelper.ExtractOptional(value)
 	if skip {
 		return nil
 	}
 	s, ok := resolved.(string)
 	if !ok || !alphaRegex.MatchString(s) {
-		return &Violation{Code: ViolationAlpha, Message: formatMessage(ViolationAlpha, nil)}
+		return &Violation{Code: ViolationAlpha, Message: FormatMessage(ViolationAlpha, nil)}
 	}
 	return nil
-}
+})
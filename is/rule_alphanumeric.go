@@ -15,14 +15,19 @@ var alphaNumericRegex = regexp.MustCompile(`^[a-zA-Z0-9]+$`)
 //
 // Optional behavior: None -> nil (absent field skips the constraint);
 // Some(v) -> validates the unwrapped value.
-var Alphanumeric Rule = func(_ context.Context, value any) *Violation {
+var Alphanumeric Rule = WithSpec(RuleSpec{
+	Name:   "Alphanumeric",
+	Code:   ViolationAlphaNum,
+	Params: map[string]any{"pattern": alphaNumericRegex.String()},
+	Kinds:  []Kind{KindString},
+}, func(_ context.Context, value any) *Violation {
 	resolved, skip := ishelper.ExtractOptional(value)
 	if skip {
 		return nil
 	}
 	s, ok := resolved.(string)
 	if !ok || !alphaNumericRegex.MatchString(s) {
-		return &Violation{Code: ViolationAlphaNum, Message: formatMessage(ViolationAlphaNum, nil)}
+		return &Violation{Code: ViolationAlphaNum, Message: FormatMessage(ViolationAlphaNum, nil)}
 	}
 	return nil
-}
+})
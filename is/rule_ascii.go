@@ -0,0 +1,17 @@
+package is
+
+import "unicode"
+
+// ASCII is a Rule that reports a violation when the string value contains
+// a non-ASCII character.
+//
+// Accepted type: string. The empty string is accepted.
+// Unsupported types and strings with other characters produce ViolationASCII.
+// Violation params: "char" (first offending character) and "position"
+// (its 0-based rune index).
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+var ASCII Rule = charsetRule("ASCII", ViolationASCII, func(r rune) bool {
+	return r <= unicode.MaxASCII
+})
@@ -0,0 +1,23 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestASCII(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	require.Nil(t, ASCII(ctx, "Hello, world! ~"))
+
+	got := ASCII(ctx, "café")
+	require.Equal(t, ViolationASCII, got.Code)
+	require.Equal(t, map[string]any{"char": "é", "position": 3}, got.Params)
+	require.Equal(t, ViolationASCII, ASCII(ctx, ishelper.Some("ß")).Code)
+	require.Equal(t, ViolationASCII, ASCII(ctx, 42).Code)
+	require.Nil(t, ASCII(ctx, ishelper.None[string]()))
+}
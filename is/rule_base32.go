@@ -0,0 +1,47 @@
+package is
+
+import (
+	"context"
+	"encoding/base32"
+	"strings"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// Base32 is a Rule that reports a violation when value is not padded standard
+// base32 (RFC 4648 §6). See Base32Of.
+var Base32 = Base32Of(base32.StdEncoding)
+
+// Base32Of returns a Rule that reports a violation when value is not text
+// encoded with enc, then evaluates rules against the decoded []byte (e.g.
+// DecodedLength). Rules are short-circuited: the first violation is returned as is.
+//
+// Accepted type: string.
+// Unsupported types, line breaks and undecodable text produce ViolationBase32.
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+func Base32Of(enc *base32.Encoding, rules ...Rule) Rule {
+	spec := RuleSpec{
+		Name:  "Base32Of",
+		Code:  ViolationBase32,
+		Kinds: []Kind{KindString},
+		Rules: DescribeRules(rules),
+	}
+	return WithSpec(spec, func(ctx context.Context, value any) *Violation {
+		resolved, skip := ishelper.ExtractOptional(value)
+		if skip {
+			return nil
+		}
+
+		s, ok := resolved.(string)
+		if !ok || strings.ContainsAny(s, "\r\n") {
+			return &Violation{Code: ViolationBase32, Message: FormatMessage(ViolationBase32, nil)}
+		}
+		b, err := enc.DecodeString(s)
+		if err != nil {
+			return &Violation{Code: ViolationBase32, Message: FormatMessage(ViolationBase32, nil)}
+		}
+		return applyRules(ctx, b, rules)
+	})
+}
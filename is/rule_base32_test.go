@@ -0,0 +1,28 @@
+package is
+
+import (
+	"context"
+	"encoding/base32"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBase32(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	require.Nil(t, Base32(ctx, "NBSWY3DP"))
+	require.Nil(t, Base32(ctx, "NBSWY3DPEE======"))
+	require.Equal(t, ViolationBase32, Base32(ctx, "NBSWY3DPEE").Code)
+	require.Equal(t, ViolationBase32, Base32(ctx, "nbswy3dp1").Code)
+	require.Equal(t, ViolationBase32, Base32(ctx, "NBSW\nY3DP").Code)
+	require.Equal(t, ViolationBase32, Base32(ctx, 1).Code)
+	require.Nil(t, Base32(ctx, ishelper.None[string]()))
+	require.Equal(t, ViolationBase32, Base32(ctx, ishelper.Some("!")).Code)
+
+	totp := Base32Of(base32.StdEncoding.WithPadding(base32.NoPadding), DecodedLength(10, 20))
+	require.Nil(t, totp(ctx, "JBSWY3DPEHPK3PXP"))
+	require.Equal(t, ViolationDecodedLength, totp(ctx, "NBSWY3DP").Code)
+}
@@ -0,0 +1,57 @@
+package is
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+var (
+	// Base64 is a Rule that reports a violation when value is not padded
+	// standard base64 (RFC 4648 §4). See Base64Of.
+	Base64 = Base64Of(base64.StdEncoding)
+	// Base64URL is like Base64 for the padded URL-safe alphabet (RFC 4648 §5).
+	Base64URL = Base64Of(base64.URLEncoding)
+	// Base64Raw is like Base64 without padding.
+	Base64Raw = Base64Of(base64.RawStdEncoding)
+	// Base64RawURL is like Base64URL without padding.
+	Base64RawURL = Base64Of(base64.RawURLEncoding)
+)
+
+// Base64Of returns a Rule that reports a violation when value is not text
+// encoded with enc, then evaluates rules against the decoded []byte (e.g.
+// DecodedLength). Rules are short-circuited: the first violation is returned as is.
+//
+// Accepted type: string.
+// Unsupported types, line breaks, non-canonical padding bits and undecodable
+// text produce ViolationBase64.
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+func Base64Of(enc *base64.Encoding, rules ...Rule) Rule {
+	strict := enc.Strict()
+	spec := RuleSpec{
+		Name:  "Base64Of",
+		Code:  ViolationBase64,
+		Kinds: []Kind{KindString},
+		Rules: DescribeRules(rules),
+	}
+	return WithSpec(spec, func(ctx context.Context, value any) *Violation {
+		resolved, skip := ishelper.ExtractOptional(value)
+		if skip {
+			return nil
+		}
+
+		s, ok := resolved.(string)
+		if !ok || strings.ContainsAny(s, "\r\n") {
+			return &Violation{Code: ViolationBase64, Message: FormatMessage(ViolationBase64, nil)}
+		}
+		b, err := strict.DecodeString(s)
+		if err != nil {
+			return &Violation{Code: ViolationBase64, Message: FormatMessage(ViolationBase64, nil)}
+		}
+		return applyRules(ctx, b, rules)
+	})
+}
@@ -0,0 +1,35 @@
+package is
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBase64(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	require.Nil(t, Base64(ctx, "aGVsbG8/Pz4+"))
+	require.Nil(t, Base64(ctx, ""))
+	require.Equal(t, ViolationBase64, Base64(ctx, "aGVsbG8").Code)
+	require.Equal(t, ViolationBase64, Base64(ctx, "aGVsbG8_Pz4-").Code)
+	require.Equal(t, ViolationBase64, Base64(ctx, "aGVs\nbG8=").Code)
+	require.Equal(t, ViolationBase64, Base64(ctx, "aGVsbG9=").Code) // non-zero padding bits
+	require.Equal(t, ViolationBase64, Base64(ctx, []byte("aGVsbG8=")).Code)
+	require.Nil(t, Base64(ctx, ishelper.None[string]()))
+	require.Nil(t, Base64(ctx, ishelper.Some("aGVsbG8=")))
+
+	require.Nil(t, Base64URL(ctx, "aGVsbG8_Pz4-"))
+	require.Equal(t, ViolationBase64, Base64URL(ctx, "aGVsbG8/Pz4+").Code)
+	require.Nil(t, Base64Raw(ctx, "aGVsbG8"))
+	require.Equal(t, ViolationBase64, Base64Raw(ctx, "aGVsbG8=").Code)
+	require.Nil(t, Base64RawURL(ctx, "aGVsbG8_Pz4-"))
+
+	key := Base64Of(base64.StdEncoding, DecodedLength(32, 32))
+	require.Nil(t, key(ctx, "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA="))
+	require.Equal(t, ViolationDecodedLength, key(ctx, "aGVsbG8=").Code)
+}
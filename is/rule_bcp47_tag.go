@@ -0,0 +1,153 @@
+package is
+
+import (
+	"context"
+	"strings"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// BCP47Tag is a Rule that reports a violation when value is not a well-formed
+// BCP 47 language tag (RFC 5646), e.g. "en", "fr-CA", "zh-Hant-TW",
+// "sr-Latn-RS", "de-CH-1996" or "en-US-x-twain".
+//
+// Subtags are case-insensitive. Two-letter language subtags must be listed in
+// Languages; longer language subtags, regions (two letters, e.g. "XK", or a
+// UN M.49 code, e.g. "419") and variants are only checked for syntax.
+// Grandfathered tags (e.g. "i-klingon") are not accepted.
+//
+// Accepted type: string.
+// Unsupported types and malformed tags produce ViolationBCP47.
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+var BCP47Tag Rule = WithSpec(RuleSpec{
+	Name:  "BCP47Tag",
+	Code:  ViolationBCP47,
+	Kinds: []Kind{KindString},
+}, func(_ context.Context, value any) *Violation {
+	resolved, skip := ishelper.ExtractOptional(value)
+	if skip {
+		return nil
+	}
+	s, ok := resolved.(string)
+	if !ok || !isBCP47(s) {
+		return &Violation{Code: ViolationBCP47, Message: FormatMessage(ViolationBCP47, nil)}
+	}
+	return nil
+})
+
+func isBCP47(tag string) bool {
+	subtags := strings.Split(strings.ToLower(tag), "-")
+	i := 0
+	next := func() string {
+		if i < len(subtags) {
+			return subtags[i]
+		}
+		return ""
+	}
+
+	// Private use only tag: x-whatever.
+	if next() == "x" {
+		return isPrivateUse(subtags[1:])
+	}
+
+	// language: 2-3 letters (+ up to 3 extlang), 4 letters (reserved) or 5-8 letters.
+	lang := next()
+	if !isAlpha(lang) || len(lang) < 2 || len(lang) > 8 {
+		return false
+	}
+	if len(lang) == 2 && !Languages[lang] {
+		return false
+	}
+	i++
+	if len(lang) <= 3 {
+		for n := 0; n < 3 && len(next()) == 3 && isAlpha(next()); n++ {
+			i++
+		}
+	}
+
+	// script: 4 letters.
+	if s := next(); len(s) == 4 && isAlpha(s) {
+		i++
+	}
+
+	// region: 2 letters or 3 digits.
+	if s := next(); len(s) == 2 && isAlpha(s) || len(s) == 3 && isDigits(s) {
+		i++
+	}
+
+	// variants: 5-8 alphanumerics, or a digit followed by 3 alphanumerics.
+	variants := map[string]bool{}
+	for s := next(); isAlnum(s) && (len(s) >= 5 && len(s) <= 8 || len(s) == 4 && s[0] >= '0' && s[0] <= '9'); s = next() {
+		if variants[s] {
+			return false
+		}
+		variants[s] = true
+		i++
+	}
+
+	// extensions: a singleton other than x followed by 2-8 alphanumeric subtags.
+	singletons := map[string]bool{}
+	for s := next(); len(s) == 1 && s != "x" && isAlnum(s); s = next() {
+		if singletons[s] {
+			return false
+		}
+		singletons[s] = true
+		i++
+		n := 0
+		for e := next(); len(e) >= 2 && len(e) <= 8 && isAlnum(e); e = next() {
+			i++
+			n++
+		}
+		if n == 0 {
+			return false
+		}
+	}
+
+	if next() == "x" {
+		return isPrivateUse(subtags[i+1:])
+	}
+	return i == len(subtags)
+}
+
+// isPrivateUse reports whether subtags are valid private use subtags (1-8
+// alphanumerics each, at least one).
+func isPrivateUse(subtags []string) bool {
+	if len(subtags) == 0 {
+		return false
+	}
+	for _, s := range subtags {
+		if len(s) < 1 || len(s) > 8 || !isAlnum(s) {
+			return false
+		}
+	}
+	return true
+}
+
+// isAlpha reports whether s is non-empty and only contains lowercase ASCII letters.
+func isAlpha(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < 'a' || s[i] > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+// isAlnum reports whether s is non-empty and only contains lowercase ASCII
+// letters and digits.
+func isAlnum(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if (s[i] < 'a' || s[i] > 'z') && (s[i] < '0' || s[i] > '9') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,31 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBCP47Tag(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	for _, tag := range []string{
+		"en", "fr-CA", "FR-ca", "zh-Hant-TW", "sr-Latn-RS", "de-CH-1996", "es-419",
+		"en-US-x-twain", "x-private", "zh-yue-HK", "sl-rozaj-biske", "en-a-bbb-b-ccc",
+		"haw", "ast-ES", "sq-XK", "en-ZZ",
+	} {
+		require.Nil(t, BCP47Tag(ctx, tag), "BCP47Tag(%q)", tag)
+	}
+	for _, tag := range []string{
+		"", "e", "xx", "en-", "en--US", "en-U1", "en-US-x", "de-CH-1996-1996",
+		"en-a-bbb-a-ccc", "en-a", "abcdefghi", "en_US", "i-klingon", "en-US-x-toolongsubtag",
+	} {
+		require.Equal(t, ViolationBCP47, BCP47Tag(ctx, tag).Code, "BCP47Tag(%q)", tag)
+	}
+	require.Equal(t, ViolationBCP47, BCP47Tag(ctx, 1).Code)
+	require.Nil(t, BCP47Tag(ctx, ishelper.None[string]()))
+	require.Nil(t, BCP47Tag(ctx, ishelper.Some("pt-BR")))
+}
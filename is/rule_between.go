@@ -7,7 +7,7 @@ import (
 
 // Between returns a Rule that reports a violation when value is outside [min, max].
 //
-// Accepted types: ishelper.Number (signed/unsigned integers, float32, float64).
+// Accepted types: all numeric types supported by ishelper.ToRat.
 // Unsupported/non-numeric values produce ViolationBetween.
 //
 // Optional behavior: None -> nil (absent field skips the constraint);
@@ -22,7 +22,13 @@ func Between[T ishelper.Number](min, max T) Rule {
 		panic("is.Between: invalid max value")
 	}
 
-	return func(_ context.Context, value any) *Violation {
+	spec := RuleSpec{
+		Name:   "Between",
+		Code:   ViolationBetween,
+		Params: map[string]any{"min": min, "max": max},
+		Kinds:  []Kind{KindNumber},
+	}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
 		resolved, skip := ishelper.ExtractOptional(value)
 		if skip {
 			return nil
@@ -31,9 +37,9 @@ func Between[T ishelper.Number](min, max T) Rule {
 		if !ok || n.Cmp(minLimit) < 0 || n.Cmp(maxLimit) > 0 {
 			return &Violation{
 				Code:    ViolationBetween,
-				Message: formatMessage(ViolationBetween, map[string]any{"min": min, "max": max}),
+				Message: FormatMessage(ViolationBetween, map[string]any{"min": min, "max": max}),
 			}
 		}
 		return nil
-	}
+	})
 }
@@ -2,6 +2,8 @@ package is
 
 import (
 	"context"
+	"encoding/json"
+	"math/big"
 	"testing"
 	"github.com/alexisvisco/valid/ishelper"
 
@@ -18,6 +20,10 @@ func TestBetween(t *testing.T) {
 	require.Equal(t, ViolationBetween, rule(context.Background(), 9).Code)
 	require.Equal(t, ViolationBetween, rule(context.Background(), 21).Code)
 	require.Equal(t, ViolationBetween, rule(context.Background(), "10").Code)
+	require.Nil(t, rule(context.Background(), big.NewInt(20)))
+	require.Nil(t, rule(context.Background(), json.Number("10.5")))
+	require.Equal(t, ViolationBetween, rule(context.Background(), big.NewRat(2001, 100)).Code)
+	require.Equal(t, ViolationBetween, rule(context.Background(), testDecimal("9.99")).Code)
 	require.Nil(t, rule(context.Background(), ishelper.None[int]()))
 	require.Nil(t, rule(context.Background(), ishelper.Some(12)))
 }
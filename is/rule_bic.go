@@ -0,0 +1,35 @@
+package is
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// bicRegex matches ISO 9362 business identifier codes: 4-letter institution,
+// 2-letter country, 2-character location and optional 3-character branch.
+var bicRegex = regexp.MustCompile(`^[A-Z]{4}[A-Z]{2}[A-Z0-9]{2}(?:[A-Z0-9]{3})?$`)
+
+// BIC is a Rule that reports a violation when value is not a BIC (SWIFT code).
+//
+// Accepted type: string (uppercase, 8 or 11 characters).
+// Unsupported types and non-matching text produce ViolationBIC.
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+var BIC Rule = WithSpec(RuleSpec{
+	Name:  "BIC",
+	Code:  ViolationBIC,
+	Kinds: []Kind{KindString},
+}, func(_ context.Context, value any) *Violation {
+	resolved, skip := ishelper.ExtractOptional(value)
+	if skip {
+		return nil
+	}
+	s, ok := resolved.(string)
+	if !ok || !bicRegex.MatchString(s) {
+		return &Violation{Code: ViolationBIC, Message: FormatMessage(ViolationBIC, nil)}
+	}
+	return nil
+})
@@ -0,0 +1,24 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBIC(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	require.Nil(t, BIC(ctx, "DEUTDEFF"))
+	require.Nil(t, BIC(ctx, "DEUTDEFF500"))
+	require.Nil(t, BIC(ctx, "NEDSZAJJXXX"))
+	require.Equal(t, ViolationBIC, BIC(ctx, "deutdeff").Code)
+	require.Equal(t, ViolationBIC, BIC(ctx, "DEUTDEFF5").Code)
+	require.Equal(t, ViolationBIC, BIC(ctx, "DEU1DEFF").Code)
+	require.Equal(t, ViolationBIC, BIC(ctx, 1).Code)
+	require.Nil(t, BIC(ctx, ishelper.None[string]()))
+	require.Nil(t, BIC(ctx, ishelper.Some("DEUTDEFF")))
+}
@@ -0,0 +1,56 @@
+package is
+
+import (
+	"context"
+	"unicode"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// CharsetOf returns a Rule that reports a violation when the string value
+// contains a character outside all of the given ranges, e.g.
+// CharsetOf(unicode.Latin, unicode.Nd, unicode.Zs).
+//
+// Accepted type: string. The empty string is accepted.
+// Unsupported types and strings with other characters produce ViolationCharset.
+// Violation params: "char" (first offending character) and "position"
+// (its 0-based rune index); both are absent for unsupported types.
+//
+// At least one range is required. Missing ranges panic at rule construction time.
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+func CharsetOf(ranges ...*unicode.RangeTable) Rule {
+	if len(ranges) == 0 {
+		panic("is.CharsetOf: at least one range is required")
+	}
+	return charsetRule("CharsetOf", ViolationCharset, func(r rune) bool {
+		return unicode.In(r, ranges...)
+	})
+}
+
+// charsetRule returns a Rule that reports code for the first rune of a string
+// value rejected by allowed, with its "char" and "position" in the params. name
+// is the name of the rule in its RuleSpec.
+func charsetRule(name string, code ViolationCode, allowed func(r rune) bool) Rule {
+	spec := RuleSpec{Name: name, Code: code, Kinds: []Kind{KindString}}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
+		resolved, skip := ishelper.ExtractOptional(value)
+		if skip {
+			return nil
+		}
+
+		s, ok := resolved.(string)
+		if !ok {
+			return &Violation{Code: code, Message: FormatMessage(code, nil)}
+		}
+		pos := 0
+		for _, r := range s {
+			if !allowed(r) {
+				params := map[string]any{"char": string(r), "position": pos}
+				return &Violation{Code: code, Message: FormatMessage(code, params), Params: params}
+			}
+			pos++
+		}
+		return nil
+	})
+}
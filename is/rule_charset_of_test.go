@@ -0,0 +1,28 @@
+package is
+
+import (
+	"context"
+	"testing"
+	"unicode"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCharsetOf(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rule := CharsetOf(unicode.Latin, unicode.Nd, unicode.Zs)
+	require.Nil(t, rule(ctx, "Zoë 42"))
+	require.Nil(t, rule(ctx, ""))
+
+	got := rule(ctx, "Zoë Ω")
+	require.Equal(t, ViolationCharset, got.Code)
+	require.Equal(t, map[string]any{"char": "Ω", "position": 4}, got.Params)
+	require.Equal(t, ViolationCharset, rule(ctx, 42).Code)
+	require.Nil(t, got.Params["missing"])
+	require.Nil(t, rule(ctx, ishelper.None[string]()))
+	require.Equal(t, ViolationCharset, rule(ctx, ishelper.Some("a-b")).Code)
+	require.Panics(t, func() { CharsetOf() })
+}
@@ -19,7 +19,13 @@ import (
 // Optional behavior: None -> nil (absent field skips the constraint);
 // Some(v) -> validates the unwrapped value.
 func Contains[T comparable](elem T) Rule {
-	return func(_ context.Context, value any) *Violation {
+	spec := RuleSpec{
+		Name:   "Contains",
+		Code:   ViolationContains,
+		Params: map[string]any{"value": elem},
+		Kinds:  []Kind{KindString, KindList},
+	}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
 		resolved, skip := ishelper.ExtractOptional(value)
 		if skip {
 			return nil
@@ -27,7 +33,7 @@ func Contains[T comparable](elem T) Rule {
 
 		violation := &Violation{
 			Code:    ViolationContains,
-			Message: formatMessage(ViolationContains, map[string]any{"value": elem}),
+			Message: FormatMessage(ViolationContains, map[string]any{"value": elem}),
 		}
 
 		// String contains substring (only when elem is a string).
@@ -53,5 +59,5 @@ func Contains[T comparable](elem T) Rule {
 		default:
 			return violation
 		}
-	}
+	})
 }
@@ -0,0 +1,46 @@
+package is
+
+import (
+	"context"
+	"slices"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// ContainsAll returns a Rule that reports a violation when a slice or array
+// does not contain every element of required.
+//
+// Accepted types: slice, array of T.
+// Unsupported types produce ViolationContainsAll.
+// Violation params: "missing" (the missing elements, comma-separated).
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+func ContainsAll[T comparable](required ...T) Rule {
+	spec := RuleSpec{
+		Name:   "ContainsAll",
+		Code:   ViolationContainsAll,
+		Params: map[string]any{"values": anySlice(required)},
+		Kinds:  []Kind{KindList},
+	}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
+		resolved, skip := ishelper.ExtractOptional(value)
+		if skip {
+			return nil
+		}
+		missing := required
+		if items, ok := sliceItems[T](resolved); ok {
+			missing = nil
+			for _, r := range required {
+				if !slices.Contains(items, r) {
+					missing = append(missing, r)
+				}
+			}
+			if len(missing) == 0 {
+				return nil
+			}
+		}
+		params := map[string]any{"missing": joinValues(missing)}
+		return &Violation{Code: ViolationContainsAll, Message: FormatMessage(ViolationContainsAll, params), Params: params}
+	})
+}
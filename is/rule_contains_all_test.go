@@ -0,0 +1,26 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContainsAll(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rule := ContainsAll("openid", "email")
+	require.Nil(t, rule(ctx, []string{"email", "profile", "openid"}))
+
+	got := rule(ctx, []string{"profile", "email"})
+	require.Equal(t, ViolationContainsAll, got.Code)
+	require.Equal(t, "must contain openid", got.Message)
+	require.Equal(t, map[string]any{"missing": "openid"}, got.Params)
+	require.Equal(t, map[string]any{"missing": "openid, email"}, rule(ctx, []string(nil)).Params)
+	require.Equal(t, ViolationContainsAll, rule(ctx, "openid email").Code)
+	require.Nil(t, rule(ctx, ishelper.None[[]string]()))
+	require.Nil(t, rule(ctx, ishelper.Some([]string{"openid", "email"})))
+}
@@ -0,0 +1,44 @@
+package is
+
+import (
+	"context"
+	"slices"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// ContainsNone returns a Rule that reports a violation when a slice or array
+// contains any element of forbidden.
+//
+// Accepted types: slice, array of T.
+// Unsupported types produce ViolationContainsNone.
+// Violation params: "index" and "value" of the first forbidden element.
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+func ContainsNone[T comparable](forbidden ...T) Rule {
+	spec := RuleSpec{
+		Name:   "ContainsNone",
+		Code:   ViolationContainsNone,
+		Params: map[string]any{"values": anySlice(forbidden)},
+		Kinds:  []Kind{KindList},
+	}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
+		resolved, skip := ishelper.ExtractOptional(value)
+		if skip {
+			return nil
+		}
+		items, ok := sliceItems[T](resolved)
+		if !ok {
+			message := FormatMessage(ViolationContainsNone, map[string]any{"value": joinValues(forbidden)})
+			return &Violation{Code: ViolationContainsNone, Message: message}
+		}
+		for i, item := range items {
+			if slices.Contains(forbidden, item) {
+				params := map[string]any{"index": i, "value": item}
+				return &Violation{Code: ViolationContainsNone, Message: FormatMessage(ViolationContainsNone, params), Params: params}
+			}
+		}
+		return nil
+	})
+}
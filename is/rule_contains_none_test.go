@@ -0,0 +1,28 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContainsNone(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rule := ContainsNone("root", "admin")
+	require.Nil(t, rule(ctx, []string{"alice", "bob"}))
+	require.Nil(t, rule(ctx, []string(nil)))
+
+	got := rule(ctx, []string{"alice", "admin"})
+	require.Equal(t, ViolationContainsNone, got.Code)
+	require.Equal(t, "must not contain admin", got.Message)
+	require.Equal(t, map[string]any{"index": 1, "value": "admin"}, got.Params)
+	got = rule(ctx, 42)
+	require.Equal(t, ViolationContainsNone, got.Code)
+	require.Equal(t, "must not contain root, admin", got.Message)
+	require.Nil(t, rule(ctx, ishelper.None[[]string]()))
+	require.Nil(t, rule(ctx, ishelper.Some([]string{"alice"})))
+}
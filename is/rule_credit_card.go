@@ -0,0 +1,140 @@
+package is
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// CardBrand identifies a payment card network.
+type CardBrand string
+
+const (
+	CardUnknown    CardBrand = ""
+	CardVisa       CardBrand = "visa"
+	CardMastercard CardBrand = "mastercard"
+	CardAmex       CardBrand = "amex"
+	CardDiscover   CardBrand = "discover"
+	CardDinersClub CardBrand = "diners_club"
+	CardJCB        CardBrand = "jcb"
+	CardUnionPay   CardBrand = "unionpay"
+	CardMaestro    CardBrand = "maestro"
+)
+
+// cardRange maps an IIN prefix range (inclusive, same number of digits) to a
+// brand and its valid number lengths. Order matters: the first match wins.
+var cardRanges = []struct {
+	from, to int
+	brand    CardBrand
+	lengths  []int
+}{
+	{34, 34, CardAmex, []int{15}},
+	{37, 37, CardAmex, []int{15}},
+	{4, 4, CardVisa, []int{13, 16, 19}},
+	{51, 55, CardMastercard, []int{16}},
+	{2221, 2720, CardMastercard, []int{16}},
+	{5018, 5018, CardMaestro, []int{12, 13, 14, 15, 16, 17, 18, 19}},
+	{5020, 5020, CardMaestro, []int{12, 13, 14, 15, 16, 17, 18, 19}},
+	{5038, 5038, CardMaestro, []int{12, 13, 14, 15, 16, 17, 18, 19}},
+	{5893, 5893, CardMaestro, []int{12, 13, 14, 15, 16, 17, 18, 19}},
+	{6304, 6304, CardMaestro, []int{12, 13, 14, 15, 16, 17, 18, 19}},
+	{6759, 6759, CardMaestro, []int{12, 13, 14, 15, 16, 17, 18, 19}},
+	{6761, 6763, CardMaestro, []int{12, 13, 14, 15, 16, 17, 18, 19}},
+	{6011, 6011, CardDiscover, []int{16, 17, 18, 19}},
+	{644, 649, CardDiscover, []int{16, 17, 18, 19}},
+	{65, 65, CardDiscover, []int{16, 17, 18, 19}},
+	{622126, 622925, CardDiscover, []int{16, 17, 18, 19}},
+	{3528, 3589, CardJCB, []int{16, 17, 18, 19}},
+	{300, 305, CardDinersClub, []int{14, 15, 16, 17, 18, 19}},
+	{36, 36, CardDinersClub, []int{14, 15, 16, 17, 18, 19}},
+	{38, 39, CardDinersClub, []int{14, 15, 16, 17, 18, 19}},
+	{62, 62, CardUnionPay, []int{16, 17, 18, 19}},
+}
+
+// DetectCardBrand returns the brand of a card number from its IIN prefix and
+// length, or CardUnknown. Spaces and hyphens are ignored; the checksum is not
+// verified.
+func DetectCardBrand(number string) CardBrand {
+	digits := stripCardSeparators(number)
+	if !isDigits(digits) {
+		return CardUnknown
+	}
+	for _, r := range cardRanges {
+		width := len(strconv.Itoa(r.from))
+		if len(digits) < width {
+			continue
+		}
+		prefix, _ := strconv.Atoi(digits[:width])
+		if prefix >= r.from && prefix <= r.to && slices.Contains(r.lengths, len(digits)) {
+			return r.brand
+		}
+	}
+	return CardUnknown
+}
+
+// CreditCard returns a Rule that reports a violation when value is not a
+// payment card number: 12 to 19 digits (spaces and hyphens allowed as
+// separators) with a valid Luhn checksum.
+//
+// When brands is non-empty, the detected brand (see DetectCardBrand) must be
+// one of them; numbers of unknown brand are then rejected.
+//
+// Accepted type: string.
+// Unsupported types, malformed numbers and bad checksums produce
+// ViolationCreditCard; a brand outside brands produces ViolationCardBrand.
+// Violation params: "brand" (detected brand, "" if unknown) and, for
+// ViolationCardBrand, "brands".
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+func CreditCard(brands ...CardBrand) Rule {
+	allowed := make([]string, len(brands))
+	for i, b := range brands {
+		allowed[i] = string(b)
+	}
+
+	spec := RuleSpec{
+		Name:       "CreditCard",
+		Code:       ViolationCreditCard,
+		OtherCodes: []ViolationCode{ViolationCardBrand},
+		Params:     map[string]any{"brands": anySlice(brands)},
+		Kinds:      []Kind{KindString},
+	}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
+		resolved, skip := ishelper.ExtractOptional(value)
+		if skip {
+			return nil
+		}
+
+		s, ok := resolved.(string)
+		if !ok {
+			return &Violation{Code: ViolationCreditCard, Message: FormatMessage(ViolationCreditCard, nil)}
+		}
+		digits := stripCardSeparators(s)
+		brand := DetectCardBrand(digits)
+		if len(digits) < 12 || len(digits) > 19 || !isDigits(digits) || !luhnValid(digits) {
+			params := map[string]any{"brand": string(brand)}
+			return &Violation{
+				Code:    ViolationCreditCard,
+				Message: FormatMessage(ViolationCreditCard, params),
+				Params:  params,
+			}
+		}
+		if len(brands) > 0 && !slices.Contains(brands, brand) {
+			params := map[string]any{"brand": string(brand), "brands": strings.Join(allowed, ", ")}
+			return &Violation{
+				Code:    ViolationCardBrand,
+				Message: FormatMessage(ViolationCardBrand, params),
+				Params:  params,
+			}
+		}
+		return nil
+	})
+}
+
+func stripCardSeparators(s string) string {
+	return strings.NewReplacer(" ", "", "-", "").Replace(s)
+}
@@ -0,0 +1,58 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectCardBrand(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]CardBrand{
+		"4111111111111111":    CardVisa,
+		"5555555555554444":    CardMastercard,
+		"2223003122003222":    CardMastercard,
+		"378282246310005":     CardAmex,
+		"6011111111111117":    CardDiscover,
+		"30569309025904":      CardDinersClub,
+		"3530111333300000":    CardJCB,
+		"6200000000000005":    CardUnionPay,
+		"6759649826438453":    CardMaestro,
+		"4111 1111 1111 1111": CardVisa,
+		"9111111111111111":    CardUnknown,
+		"37828224631000":      CardUnknown, // Amex prefix, wrong length
+		"abc":                 CardUnknown,
+	}
+	for number, want := range tests {
+		require.Equal(t, want, DetectCardBrand(number), "DetectCardBrand(%q)", number)
+	}
+}
+
+func TestCreditCard(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rule := CreditCard()
+	require.Nil(t, rule(ctx, "4111111111111111"))
+	require.Nil(t, rule(ctx, "4111-1111-1111-1111"))
+	require.Nil(t, rule(ctx, "378282246310005"))
+
+	got := rule(ctx, "4111111111111112")
+	require.Equal(t, ViolationCreditCard, got.Code)
+	require.Equal(t, map[string]any{"brand": "visa"}, got.Params)
+	require.Equal(t, ViolationCreditCard, rule(ctx, "4111").Code)
+	require.Equal(t, ViolationCreditCard, rule(ctx, "4111x11111111111").Code)
+	require.Equal(t, ViolationCreditCard, rule(ctx, 4111111111111111).Code)
+	require.Nil(t, rule(ctx, ishelper.None[string]()))
+	require.Nil(t, rule(ctx, ishelper.Some("5555555555554444")))
+
+	visaMC := CreditCard(CardVisa, CardMastercard)
+	require.Nil(t, visaMC(ctx, "5555555555554444"))
+	got = visaMC(ctx, "378282246310005")
+	require.Equal(t, ViolationCardBrand, got.Code)
+	require.Equal(t, map[string]any{"brand": "amex", "brands": "visa, mastercard"}, got.Params)
+	require.Equal(t, "card brand must be one of visa, mastercard", got.Message)
+}
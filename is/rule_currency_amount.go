@@ -0,0 +1,52 @@
+package is
+
+import (
+	"context"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// CurrencyAmount returns a Rule that reports a violation when the numeric
+// value has more decimals than the minor unit of currency allows (2 for EUR,
+// 0 for JPY, 3 for KWD, ...), compared exactly on ishelper rationals.
+//
+// Accepted types: all numeric types supported by ishelper.ToRat.
+// Floats are read through their shortest decimal representation.
+// Unsupported/non-numeric values and extra decimals produce ViolationCurrencyAmount.
+// Violation params: "currency" and "decimals".
+//
+// currency must be listed in Currencies. Unknown currencies panic at rule
+// construction time.
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+func CurrencyAmount(currency string) Rule {
+	decimals, ok := Currencies[currency]
+	if !ok {
+		panic("is.CurrencyAmount: unknown currency " + currency)
+	}
+	spec := RuleSpec{
+		Name:   "CurrencyAmount",
+		Code:   ViolationCurrencyAmount,
+		Params: map[string]any{"currency": currency, "decimals": decimals},
+		Kinds:  []Kind{KindNumber},
+	}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
+		resolved, skip := ishelper.ExtractOptional(value)
+		if skip {
+			return nil
+		}
+
+		r, ok := ishelper.ToDecimalRat(resolved)
+		if ok {
+			if d, finite := ishelper.Decimals(r); finite && d <= decimals {
+				return nil
+			}
+		}
+		params := map[string]any{"currency": currency, "decimals": decimals}
+		return &Violation{
+			Code:    ViolationCurrencyAmount,
+			Message: FormatMessage(ViolationCurrencyAmount, params),
+			Params:  params,
+		}
+	})
+}
@@ -0,0 +1,39 @@
+package is
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCurrencyAmount(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	eur := CurrencyAmount("EUR")
+	require.Nil(t, eur(ctx, 19.99))
+	require.Nil(t, eur(ctx, 20))
+	require.Nil(t, eur(ctx, json.Number("0.10")))
+	got := eur(ctx, 19.999)
+	require.Equal(t, ViolationCurrencyAmount, got.Code)
+	require.Equal(t, map[string]any{"currency": "EUR", "decimals": 2}, got.Params)
+	require.Equal(t, "must have at most 2 decimal places for EUR", got.Message)
+	got.Params["decimals"] = 0 // violations do not share their params
+	require.Equal(t, map[string]any{"currency": "EUR", "decimals": 2}, eur(ctx, 19.999).Params)
+	require.Equal(t, ViolationCurrencyAmount, eur(ctx, "19.99").Code)
+	require.Nil(t, eur(ctx, ishelper.None[float64]()))
+
+	jpy := CurrencyAmount("JPY")
+	require.Nil(t, jpy(ctx, 1500))
+	require.Equal(t, ViolationCurrencyAmount, jpy(ctx, 1500.5).Code)
+
+	kwd := CurrencyAmount("KWD")
+	require.Nil(t, kwd(ctx, big.NewRat(1234, 1000)))
+	require.Equal(t, ViolationCurrencyAmount, kwd(ctx, big.NewRat(1, 3)).Code)
+
+	require.Panics(t, func() { CurrencyAmount("XYZ") })
+}
@@ -0,0 +1,43 @@
+package is
+
+import (
+	"context"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// DecodedLength returns a Rule that reports a violation when the number of
+// decoded bytes falls outside the inclusive range [min, max]. It is meant to be
+// passed to a decoding rule so limits apply to the payload rather than the
+// encoded text, e.g. Base64Of(base64.StdEncoding, DecodedLength(16, 64)).
+//
+// Accepted type: []byte.
+// Unsupported types produce ViolationDecodedLength.
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+func DecodedLength(min, max int) Rule {
+	spec := RuleSpec{
+		Name:   "DecodedLength",
+		Code:   ViolationDecodedLength,
+		Params: map[string]any{"min": min, "max": max},
+		Kinds:  []Kind{KindBytes},
+	}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
+		resolved, skip := ishelper.ExtractOptional(value)
+		if skip {
+			return nil
+		}
+
+		b, ok := resolved.([]byte)
+		if !ok || len(b) < min || len(b) > max {
+			params := map[string]any{"min": min, "max": max}
+			return &Violation{
+				Code:    ViolationDecodedLength,
+				Message: FormatMessage(ViolationDecodedLength, params),
+				Params:  params,
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,25 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodedLength(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rule := DecodedLength(2, 4)
+	require.Nil(t, rule(ctx, []byte("ab")))
+	require.Nil(t, rule(ctx, []byte("abcd")))
+	require.Equal(t, ViolationDecodedLength, rule(ctx, []byte("a")).Code)
+	require.Equal(t, ViolationDecodedLength, rule(ctx, []byte("abcde")).Code)
+	require.Equal(t, ViolationDecodedLength, rule(ctx, "abc").Code)
+	rule(ctx, nil).Params["min"] = 0 // violations do not share their params
+	require.Equal(t, map[string]any{"min": 2, "max": 4}, rule(ctx, nil).Params)
+	require.Nil(t, rule(ctx, ishelper.None[[]byte]()))
+	require.Nil(t, rule(ctx, ishelper.Some([]byte("abc"))))
+}
@@ -0,0 +1,35 @@
+package is
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+var e164Regex = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+
+// E164 is a Rule that reports a violation when value is not a phone number in
+// strict E.164 format: "+", a calling code and up to 15 digits in total, with
+// no separators. Use PhoneNumber to accept human-formatted input.
+//
+// Accepted type: string.
+// Unsupported types produce ViolationE164.
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+var E164 Rule = WithSpec(RuleSpec{
+	Name:   "E164",
+	Code:   ViolationE164,
+	Params: map[string]any{"pattern": e164Regex.String()},
+	Kinds:  []Kind{KindString},
+}, func(_ context.Context, value any) *Violation {
+	resolved, skip := ishelper.ExtractOptional(value)
+	if skip {
+		return nil
+	}
+	if s, ok := resolved.(string); ok && e164Regex.MatchString(s) {
+		return nil
+	}
+	return &Violation{Code: ViolationE164, Message: FormatMessage(ViolationE164, nil)}
+})
@@ -0,0 +1,27 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestE164(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	require.Nil(t, E164(ctx, "+33612345678"))
+	require.Nil(t, E164(ctx, "+14155550100"))
+	require.Nil(t, E164(ctx, "+123456789012345"))
+
+	require.Equal(t, ViolationE164, E164(ctx, "33612345678").Code)
+	require.Equal(t, ViolationE164, E164(ctx, "+33 6 12 34 56 78").Code)
+	require.Equal(t, ViolationE164, E164(ctx, "+0612345678").Code)
+	require.Equal(t, ViolationE164, E164(ctx, "+1234567890123456").Code)
+	require.Equal(t, ViolationE164, E164(ctx, "+1").Code)
+	require.Equal(t, ViolationE164, E164(ctx, 33612345678).Code)
+	require.Nil(t, E164(ctx, ishelper.None[string]()))
+	require.Nil(t, E164(ctx, ishelper.Some("+33612345678")))
+}
@@ -13,18 +13,22 @@ import (
 //
 // Optional behavior: None -> nil (absent field skips the constraint);
 // Some(v) -> validates the unwrapped value.
-func Email(_ context.Context, value any) *Violation {
+var Email Rule = WithSpec(RuleSpec{
+	Name:  "Email",
+	Code:  ViolationEmail,
+	Kinds: []Kind{KindString},
+}, func(_ context.Context, value any) *Violation {
 	resolved, skip := ishelper.ExtractOptional(value)
 	if skip {
 		return nil
 	}
 	s, ok := resolved.(string)
 	if !ok {
-		return &Violation{Code: ViolationEmail, Message: formatMessage(ViolationEmail, nil)}
+		return &Violation{Code: ViolationEmail, Message: FormatMessage(ViolationEmail, nil)}
 	}
 	addr, err := mail.ParseAddress(s)
 	if err != nil || addr.Address != s {
-		return &Violation{Code: ViolationEmail, Message: formatMessage(ViolationEmail, nil)}
+		return &Violation{Code: ViolationEmail, Message: FormatMessage(ViolationEmail, nil)}
 	}
 	return nil
-}
+})
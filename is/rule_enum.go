@@ -0,0 +1,110 @@
+package is
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// Enum is a Rule that reports a violation when value is not a declared
+// constant of its enum type. The allowed set comes from the type itself, so
+// adding a constant updates validation:
+//
+//   - a Values() []T method, where T is the type of value: value must be one
+//     of the returned values;
+//   - otherwise a Valid() bool method: value must report true;
+//   - otherwise a String() string method generated by stringer: value must
+//     not print as the "T(n)" fallback used for undeclared values.
+//
+// Accepted types: types with one of the methods above.
+// Unsupported types and values outside the enum produce ViolationEnum.
+// Violation params: "type" (the type name) and, for types with Values,
+// "values" (comma-separated).
+//
+// Pointers are dereferenced; nil pointers produce ViolationEnum.
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+var Enum Rule = WithSpec(RuleSpec{Name: "Enum", Code: ViolationEnum}, func(_ context.Context, value any) *Violation {
+	resolved, skip := ishelper.ExtractOptional(value)
+	if skip {
+		return nil
+	}
+	rv := reflect.ValueOf(resolved)
+	if !rv.IsValid() {
+		return &Violation{Code: ViolationEnum, Message: FormatMessage(ViolationEnum, map[string]any{"type": "value"})}
+	}
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			params := map[string]any{"type": enumTypeName(rv.Type())}
+			return &Violation{Code: ViolationEnum, Message: FormatMessage(ViolationEnum, params), Params: params}
+		}
+		rv = rv.Elem()
+		resolved = rv.Interface()
+	}
+	params := map[string]any{"type": rv.Type().Name()}
+
+	if values, ok := enumValues(rv); ok {
+		for _, v := range values {
+			if v == resolved {
+				return nil
+			}
+		}
+		params["values"] = joinValues(values)
+	} else if v, ok := resolved.(interface{ Valid() bool }); ok {
+		if v.Valid() {
+			return nil
+		}
+	} else if v, ok := resolved.(fmt.Stringer); ok {
+		if !isStringerFallback(v.String(), rv.Type().Name()) {
+			return nil
+		}
+	}
+	return &Violation{Code: ViolationEnum, Message: FormatMessage(ViolationEnum, params), Params: params}
+})
+
+// enumTypeName returns the name of the type pointed to by t.
+func enumTypeName(t reflect.Type) string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.Name()
+}
+
+// enumValues calls the Values method of rv if it returns a slice of rv's own
+// comparable type.
+func enumValues(rv reflect.Value) ([]any, bool) {
+	m := rv.MethodByName("Values")
+	if !m.IsValid() || !rv.Comparable() {
+		return nil, false
+	}
+	mt := m.Type()
+	if mt.NumIn() != 0 || mt.NumOut() != 1 || mt.Out(0).Kind() != reflect.Slice || mt.Out(0).Elem() != rv.Type() {
+		return nil, false
+	}
+	out := m.Call(nil)[0]
+	values := make([]any, out.Len())
+	for i := range values {
+		values[i] = out.Index(i).Interface()
+	}
+	return values, true
+}
+
+// isStringerFallback reports whether s is the "Type(n)" representation that
+// stringer-generated String methods return for undeclared values.
+func isStringerFallback(s, typeName string) bool {
+	n, ok := strings.CutPrefix(s, typeName+"(")
+	if !ok {
+		return false
+	}
+	n, ok = strings.CutSuffix(n, ")")
+	if !ok {
+		return false
+	}
+	_, err := strconv.ParseInt(n, 10, 64)
+	return err == nil
+}
@@ -0,0 +1,73 @@
+package is
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+type testStatus string
+
+const (
+	testStatusDraft     testStatus = "draft"
+	testStatusPublished testStatus = "published"
+)
+
+func (testStatus) Values() []testStatus { return []testStatus{testStatusDraft, testStatusPublished} }
+
+type testLevel int
+
+func (l testLevel) Valid() bool { return l >= 1 && l <= 3 }
+
+// testColor mimics a stringer-generated type.
+type testColor int
+
+func (c testColor) String() string {
+	switch c {
+	case 0:
+		return "Red"
+	case 1:
+		return "Green"
+	default:
+		return "testColor(" + strconv.Itoa(int(c)) + ")"
+	}
+}
+
+func TestEnum(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	require.Nil(t, Enum(ctx, testStatusDraft))
+	require.Nil(t, Enum(ctx, testStatus("published")))
+	got := Enum(ctx, testStatus("archived"))
+	require.Equal(t, ViolationEnum, got.Code)
+	require.Equal(t, "must be a valid testStatus", got.Message)
+	require.Equal(t, map[string]any{"type": "testStatus", "values": "draft, published"}, got.Params)
+
+	require.Nil(t, Enum(ctx, testLevel(2)))
+	got = Enum(ctx, testLevel(4))
+	require.Equal(t, ViolationEnum, got.Code)
+	require.Equal(t, map[string]any{"type": "testLevel"}, got.Params)
+
+	require.Nil(t, Enum(ctx, testColor(1)))
+	require.Equal(t, ViolationEnum, Enum(ctx, testColor(7)).Code)
+	require.Equal(t, ViolationEnum, Enum(ctx, testColor(-1)).Code)
+
+	level := testLevel(2)
+	require.Nil(t, Enum(ctx, &level))
+	level = 9
+	require.Equal(t, "must be a valid testLevel", Enum(ctx, &level).Message)
+	got = Enum(ctx, (*testLevel)(nil))
+	require.Equal(t, ViolationEnum, got.Code)
+	require.Equal(t, "must be a valid testLevel", got.Message)
+	require.Equal(t, ViolationEnum, Enum(ctx, (*testColor)(nil)).Code)
+
+	require.Equal(t, ViolationEnum, Enum(ctx, "draft").Code)
+	require.Equal(t, ViolationEnum, Enum(ctx, nil).Code)
+	require.Nil(t, Enum(ctx, ishelper.None[testStatus]()))
+	require.Nil(t, Enum(ctx, ishelper.Some(testStatusDraft)))
+	require.Equal(t, ViolationEnum, Enum(ctx, ishelper.Some(testLevel(0))).Code)
+}
@@ -13,7 +13,8 @@ import (
 // Optional behaviour: None -> nil (absent field skips the constraint);
 // Some(v) -> validates the unwrapped value.
 func Equal[T comparable](target T) Rule {
-	return func(_ context.Context, value any) *Violation {
+	spec := RuleSpec{Name: "Equal", Code: ViolationEQ, Params: map[string]any{"value": target}}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
 		resolved, skip := ishelper.ExtractOptional(value)
 		if skip {
 			return nil
@@ -23,9 +24,9 @@ func Equal[T comparable](target T) Rule {
 		if !ok || v != target {
 			return &Violation{
 				Code:    ViolationEQ,
-				Message: formatMessage(ViolationEQ, map[string]any{"value": target}),
+				Message: FormatMessage(ViolationEQ, map[string]any{"value": target}),
 			}
 		}
 		return nil
-	}
+	})
 }
@@ -0,0 +1,40 @@
+package is
+
+import (
+	"context"
+	"strings"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// EqualFold returns a Rule that reports a violation when value is not equal to
+// target under Unicode case folding.
+//
+// Accepted type: string.
+// Unsupported types and different values produce ViolationEQ.
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+func EqualFold(target string) Rule {
+	spec := RuleSpec{
+		Name:   "EqualFold",
+		Code:   ViolationEQ,
+		Params: map[string]any{"value": target},
+		Kinds:  []Kind{KindString},
+	}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
+		resolved, skip := ishelper.ExtractOptional(value)
+		if skip {
+			return nil
+		}
+
+		s, ok := resolved.(string)
+		if !ok || !strings.EqualFold(s, target) {
+			return &Violation{
+				Code:    ViolationEQ,
+				Message: FormatMessage(ViolationEQ, map[string]any{"value": target}),
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,26 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEqualFold(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rule := EqualFold("Confirm")
+	require.Nil(t, rule(ctx, "confirm"))
+	require.Nil(t, rule(ctx, "CONFIRM"))
+
+	got := rule(ctx, "confirmed")
+	require.Equal(t, ViolationEQ, got.Code)
+	require.Equal(t, "must be = Confirm", got.Message)
+	require.Equal(t, ViolationEQ, rule(ctx, 1).Code)
+	require.Nil(t, EqualFold("ΣΑΣ")(ctx, "σας"))
+	require.Nil(t, rule(ctx, ishelper.None[string]()))
+	require.Nil(t, rule(ctx, ishelper.Some("confirm")))
+}
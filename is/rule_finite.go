@@ -0,0 +1,48 @@
+package is
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"reflect"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// Finite is a Rule that reports a violation when value is NaN or infinite.
+//
+// Accepted types: all numeric types supported by ishelper.ToRat.
+// NaN, ±Inf (float32, float64, named float types, *big.Float) and
+// unsupported/non-numeric values produce ViolationFinite.
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+var Finite Rule = WithSpec(RuleSpec{
+	Name:  "Finite",
+	Code:  ViolationFinite,
+	Kinds: []Kind{KindNumber},
+}, func(_ context.Context, value any) *Violation {
+	resolved, skip := ishelper.ExtractOptional(value)
+	if skip {
+		return nil
+	}
+
+	if isFinite(resolved) {
+		return nil
+	}
+	return &Violation{Code: ViolationFinite, Message: FormatMessage(ViolationFinite, nil)}
+})
+
+func isFinite(value any) bool {
+	if f, ok := value.(*big.Float); ok {
+		return f != nil && !f.IsInf()
+	}
+	if value != nil {
+		rv := reflect.ValueOf(value)
+		if rv.Kind() == reflect.Float32 || rv.Kind() == reflect.Float64 {
+			return !math.IsNaN(rv.Float()) && !math.IsInf(rv.Float(), 0)
+		}
+	}
+	_, ok := ishelper.ToRat(value)
+	return ok
+}
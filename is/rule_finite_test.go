@@ -0,0 +1,31 @@
+package is
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFinite(t *testing.T) {
+	t.Parallel()
+
+	type score float64
+
+	ctx := context.Background()
+	require.Nil(t, Finite(ctx, 1.5))
+	require.Nil(t, Finite(ctx, 42))
+	require.Nil(t, Finite(ctx, big.NewFloat(3)))
+	require.Nil(t, Finite(ctx, score(2)))
+	require.Equal(t, ViolationFinite, Finite(ctx, math.NaN()).Code)
+	require.Equal(t, ViolationFinite, Finite(ctx, math.Inf(1)).Code)
+	require.Equal(t, ViolationFinite, Finite(ctx, float32(math.Inf(-1))).Code)
+	require.Equal(t, ViolationFinite, Finite(ctx, score(math.NaN())).Code)
+	require.Equal(t, ViolationFinite, Finite(ctx, new(big.Float).SetInf(true)).Code)
+	require.Equal(t, ViolationFinite, Finite(ctx, "1").Code)
+	require.Nil(t, Finite(ctx, ishelper.None[float64]()))
+	require.Equal(t, ViolationFinite, Finite(ctx, ishelper.Some(math.NaN())).Code)
+}
@@ -15,7 +15,13 @@ import (
 // Optional behavior: None -> nil (absent field skips the constraint);
 // Some(v) -> validates the unwrapped value.
 func HasPrefix(prefix string) Rule {
-	return func(_ context.Context, value any) *Violation {
+	spec := RuleSpec{
+		Name:   "HasPrefix",
+		Code:   ViolationHasPrefix,
+		Params: map[string]any{"prefix": prefix},
+		Kinds:  []Kind{KindString},
+	}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
 		resolved, skip := ishelper.ExtractOptional(value)
 		if skip {
 			return nil
@@ -25,9 +31,9 @@ func HasPrefix(prefix string) Rule {
 		if !ok || !strings.HasPrefix(s, prefix) {
 			return &Violation{
 				Code:    ViolationHasPrefix,
-				Message: formatMessage(ViolationHasPrefix, map[string]any{"prefix": prefix}),
+				Message: FormatMessage(ViolationHasPrefix, map[string]any{"prefix": prefix}),
 			}
 		}
 		return nil
-	}
+	})
 }
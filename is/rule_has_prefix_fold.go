@@ -0,0 +1,53 @@
+package is
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// HasPrefixFold returns a Rule that reports a violation when the string value
+// does not start with prefix under Unicode case folding.
+//
+// Accepted type: string.
+// Unsupported/non-string values produce ViolationHasPrefix.
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+func HasPrefixFold(prefix string) Rule {
+	n := utf8.RuneCountInString(prefix)
+	spec := RuleSpec{
+		Name:   "HasPrefixFold",
+		Code:   ViolationHasPrefix,
+		Params: map[string]any{"prefix": prefix},
+		Kinds:  []Kind{KindString},
+	}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
+		resolved, skip := ishelper.ExtractOptional(value)
+		if skip {
+			return nil
+		}
+
+		s, ok := resolved.(string)
+		if !ok || !strings.EqualFold(runePrefix(s, n), prefix) {
+			return &Violation{
+				Code:    ViolationHasPrefix,
+				Message: FormatMessage(ViolationHasPrefix, map[string]any{"prefix": prefix}),
+			}
+		}
+		return nil
+	})
+}
+
+// runePrefix returns the first n runes of s.
+func runePrefix(s string, n int) string {
+	for i := range s {
+		if n == 0 {
+			return s[:i]
+		}
+		n--
+	}
+	return s
+}
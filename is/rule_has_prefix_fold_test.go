@@ -0,0 +1,28 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHasPrefixFold(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rule := HasPrefixFold("https://")
+	require.Nil(t, rule(ctx, "https://example.com"))
+	require.Nil(t, rule(ctx, "HTTPS://EXAMPLE.COM"))
+	require.Nil(t, HasPrefixFold("")(ctx, "anything"))
+	require.Nil(t, HasPrefixFold("é")(ctx, "École"))
+
+	got := rule(ctx, "http://example.com")
+	require.Equal(t, ViolationHasPrefix, got.Code)
+	require.Equal(t, "must start with https://", got.Message)
+	require.Equal(t, ViolationHasPrefix, rule(ctx, "https:").Code)
+	require.Equal(t, ViolationHasPrefix, rule(ctx, 1).Code)
+	require.Nil(t, rule(ctx, ishelper.None[string]()))
+	require.Nil(t, rule(ctx, ishelper.Some("Https://x")))
+}
@@ -15,7 +15,13 @@ import (
 // Optional behavior: None -> nil (absent field skips the constraint);
 // Some(v) -> validates the unwrapped value.
 func HasSuffix(suffix string) Rule {
-	return func(_ context.Context, value any) *Violation {
+	spec := RuleSpec{
+		Name:   "HasSuffix",
+		Code:   ViolationHasSuffix,
+		Params: map[string]any{"suffix": suffix},
+		Kinds:  []Kind{KindString},
+	}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
 		resolved, skip := ishelper.ExtractOptional(value)
 		if skip {
 			return nil
@@ -25,9 +31,9 @@ func HasSuffix(suffix string) Rule {
 		if !ok || !strings.HasSuffix(s, suffix) {
 			return &Violation{
 				Code:    ViolationHasSuffix,
-				Message: formatMessage(ViolationHasSuffix, map[string]any{"suffix": suffix}),
+				Message: FormatMessage(ViolationHasSuffix, map[string]any{"suffix": suffix}),
 			}
 		}
 		return nil
-	}
+	})
 }
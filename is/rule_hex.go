@@ -0,0 +1,35 @@
+package is
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// Hex is a Rule that reports a violation when value is not hex-encoded bytes.
+//
+// Accepted type: string (even number of case-insensitive hexadecimal digits,
+// without "0x" prefix).
+// Unsupported types and undecodable text produce ViolationHex.
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+var Hex Rule = WithSpec(RuleSpec{
+	Name:  "Hex",
+	Code:  ViolationHex,
+	Kinds: []Kind{KindString},
+}, func(_ context.Context, value any) *Violation {
+	resolved, skip := ishelper.ExtractOptional(value)
+	if skip {
+		return nil
+	}
+	s, ok := resolved.(string)
+	if !ok {
+		return &Violation{Code: ViolationHex, Message: FormatMessage(ViolationHex, nil)}
+	}
+	if _, err := hex.DecodeString(s); err != nil {
+		return &Violation{Code: ViolationHex, Message: FormatMessage(ViolationHex, nil)}
+	}
+	return nil
+})
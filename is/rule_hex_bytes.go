@@ -0,0 +1,45 @@
+package is
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// HexBytes returns a Rule that reports a violation when value is not exactly n
+// hex-encoded bytes (2n hexadecimal digits), e.g. HexBytes(32) for a SHA-256 digest.
+//
+// Accepted type: string.
+// Unsupported types, undecodable text and other lengths produce ViolationHexBytes.
+// Violation params: "bytes".
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+func HexBytes(n int) Rule {
+	spec := RuleSpec{
+		Name:   "HexBytes",
+		Code:   ViolationHexBytes,
+		Params: map[string]any{"bytes": n},
+		Kinds:  []Kind{KindString},
+	}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
+		resolved, skip := ishelper.ExtractOptional(value)
+		if skip {
+			return nil
+		}
+
+		s, ok := resolved.(string)
+		if ok && len(s) == 2*n {
+			if _, err := hex.DecodeString(s); err == nil {
+				return nil
+			}
+		}
+		params := map[string]any{"bytes": n}
+		return &Violation{
+			Code:    ViolationHexBytes,
+			Message: FormatMessage(ViolationHexBytes, params),
+			Params:  params,
+		}
+	})
+}
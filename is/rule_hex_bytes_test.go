@@ -0,0 +1,25 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHexBytes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rule := HexBytes(4)
+	require.Nil(t, rule(ctx, "deadbeef"))
+	require.Equal(t, ViolationHexBytes, rule(ctx, "deadbe").Code)
+	require.Equal(t, ViolationHexBytes, rule(ctx, "deadbeefff").Code)
+	require.Equal(t, ViolationHexBytes, rule(ctx, "deadbeeg").Code)
+	require.Equal(t, ViolationHexBytes, rule(ctx, []byte("deadbeef")).Code)
+	rule(ctx, "x").Params["bytes"] = 0 // violations do not share their params
+	require.Equal(t, map[string]any{"bytes": 4}, rule(ctx, "x").Params)
+	require.Nil(t, rule(ctx, ishelper.None[string]()))
+	require.Nil(t, rule(ctx, ishelper.Some("00000000")))
+}
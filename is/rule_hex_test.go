@@ -0,0 +1,23 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHex(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	require.Nil(t, Hex(ctx, "deadBEEF"))
+	require.Nil(t, Hex(ctx, ""))
+	require.Equal(t, ViolationHex, Hex(ctx, "abc").Code)
+	require.Equal(t, ViolationHex, Hex(ctx, "0xab").Code)
+	require.Equal(t, ViolationHex, Hex(ctx, "zz").Code)
+	require.Equal(t, ViolationHex, Hex(ctx, 0xab).Code)
+	require.Nil(t, Hex(ctx, ishelper.None[string]()))
+	require.Nil(t, Hex(ctx, ishelper.Some("00ff")))
+}
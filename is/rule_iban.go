@@ -0,0 +1,64 @@
+package is
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+var ibanRegex = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[A-Z0-9]{11,30}$`)
+
+// IBAN is a Rule that reports a violation when value is not an International
+// Bank Account Number: a country listed in IBANLengths, the length registered
+// for that country and a valid ISO 7064 mod-97 checksum.
+//
+// Accepted type: string, in electronic ("FR7630006000011234567890189") or
+// print ("FR76 3000 6000 ...") format; letters are case-insensitive.
+// Unsupported types, unknown countries, wrong lengths and bad checksums
+// produce ViolationIBAN.
+// Violation params: "country" (the first two letters, uppercased) when present.
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+var IBAN Rule = WithSpec(RuleSpec{
+	Name:  "IBAN",
+	Code:  ViolationIBAN,
+	Kinds: []Kind{KindString},
+}, func(_ context.Context, value any) *Violation {
+	resolved, skip := ishelper.ExtractOptional(value)
+	if skip {
+		return nil
+	}
+	s, ok := resolved.(string)
+	if !ok {
+		return &Violation{Code: ViolationIBAN, Message: FormatMessage(ViolationIBAN, nil)}
+	}
+
+	iban := strings.ToUpper(strings.ReplaceAll(s, " ", ""))
+	if ibanRegex.MatchString(iban) && len(iban) == IBANLengths[iban[:2]] && ibanChecksum(iban) == 1 {
+		return nil
+	}
+	var params map[string]any
+	if len(iban) >= 2 {
+		params = map[string]any{"country": iban[:2]}
+	}
+	return &Violation{Code: ViolationIBAN, Message: FormatMessage(ViolationIBAN, params), Params: params}
+})
+
+// ibanChecksum computes the ISO 7064 mod-97 remainder of an uppercase IBAN
+// after moving its first four characters to the end.
+func ibanChecksum(iban string) int {
+	rearranged := iban[4:] + iban[:4]
+	rem := 0
+	for i := 0; i < len(rearranged); i++ {
+		c := rearranged[i]
+		if c >= 'A' && c <= 'Z' {
+			rem = (rem*100 + int(c-'A') + 10) % 97
+		} else {
+			rem = (rem*10 + int(c-'0')) % 97
+		}
+	}
+	return rem
+}
@@ -0,0 +1,30 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIBAN(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	require.Nil(t, IBAN(ctx, "GB82WEST12345698765432"))
+	require.Nil(t, IBAN(ctx, "DE89 3704 0044 0532 0130 00"))
+	require.Nil(t, IBAN(ctx, "fr1420041010050500013m02606"))
+	require.Nil(t, IBAN(ctx, "NO9386011117947"))
+
+	got := IBAN(ctx, "GB82WEST12345698765431")
+	require.Equal(t, ViolationIBAN, got.Code)
+	require.Equal(t, map[string]any{"country": "GB"}, got.Params)
+	require.Equal(t, ViolationIBAN, IBAN(ctx, "GB82WEST1234569876543").Code)
+	require.Equal(t, ViolationIBAN, IBAN(ctx, "US82WEST12345698765432").Code)
+	require.Equal(t, ViolationIBAN, IBAN(ctx, "GB82-WEST-1234-5698-7654-32").Code)
+	require.Equal(t, ViolationIBAN, IBAN(ctx, "").Code)
+	require.Equal(t, ViolationIBAN, IBAN(ctx, 42).Code)
+	require.Nil(t, IBAN(ctx, ishelper.None[string]()))
+	require.Nil(t, IBAN(ctx, ishelper.Some("GB82WEST12345698765432")))
+}
@@ -0,0 +1,56 @@
+package is
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// IntString returns a Rule that parses a base-10 integer string and evaluates
+// rules against the parsed *big.Int. Rules are short-circuited: the first
+// violation is returned as is.
+//
+// Accepted type: string, e.g. "42", "-7", "+3".
+// Unsupported types and non-integer text produce ViolationInteger.
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+func IntString(rules ...Rule) Rule {
+	return IntStringBase(10, rules...)
+}
+
+// IntStringBase is like IntString but parses the string in the given base,
+// following big.Int.SetString: base 0 detects the "0x", "0o"/"0" and "0b"
+// prefixes (and allows underscores), while bases 2 to 62 expect bare digits.
+//
+// Invalid bases panic at rule construction time.
+func IntStringBase(base int, rules ...Rule) Rule {
+	if base != 0 && (base < 2 || base > big.MaxBase) {
+		panic("is.IntStringBase: invalid base")
+	}
+
+	spec := RuleSpec{
+		Name:   "IntStringBase",
+		Code:   ViolationInteger,
+		Params: map[string]any{"base": base},
+		Kinds:  []Kind{KindString},
+		Rules:  DescribeRules(rules),
+	}
+	return WithSpec(spec, func(ctx context.Context, value any) *Violation {
+		resolved, skip := ishelper.ExtractOptional(value)
+		if skip {
+			return nil
+		}
+
+		s, ok := resolved.(string)
+		if !ok {
+			return &Violation{Code: ViolationInteger, Message: FormatMessage(ViolationInteger, nil)}
+		}
+		n, ok := new(big.Int).SetString(s, base)
+		if !ok {
+			return &Violation{Code: ViolationInteger, Message: FormatMessage(ViolationInteger, nil)}
+		}
+		return applyRules(ctx, n, rules)
+	})
+}
@@ -0,0 +1,51 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntString(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rule := IntString(Between(1, 100))
+	require.Nil(t, rule(ctx, "1"))
+	require.Nil(t, rule(ctx, "+100"))
+	require.Equal(t, ViolationBetween, rule(ctx, "101").Code)
+	require.Equal(t, ViolationBetween, rule(ctx, "-5").Code)
+	require.Equal(t, ViolationInteger, rule(ctx, "1.5").Code)
+	require.Equal(t, ViolationInteger, rule(ctx, "0x10").Code)
+	require.Equal(t, ViolationInteger, rule(ctx, "").Code)
+	require.Equal(t, ViolationInteger, rule(ctx, 5).Code)
+	require.Nil(t, rule(ctx, ishelper.None[string]()))
+	require.Equal(t, ViolationBetween, rule(ctx, ishelper.Some("0")).Code)
+	require.Nil(t, IntString()(ctx, "123456789012345678901234567890"))
+}
+
+func TestIntStringBase(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	auto := IntStringBase(0, Max(255))
+	require.Nil(t, auto(ctx, "0xff"))
+	require.Nil(t, auto(ctx, "0b1010"))
+	require.Nil(t, auto(ctx, "0o17"))
+	require.Nil(t, auto(ctx, "42"))
+	require.Equal(t, ViolationMax, auto(ctx, "0x100").Code)
+	require.Equal(t, ViolationInteger, auto(ctx, "0xzz").Code)
+
+	hex := IntStringBase(16)
+	require.Nil(t, hex(ctx, "DEADbeef"))
+	require.Equal(t, ViolationInteger, hex(ctx, "0xff").Code)
+
+	bin := IntStringBase(2)
+	require.Nil(t, bin(ctx, "1011"))
+	require.Equal(t, ViolationInteger, bin(ctx, "102").Code)
+
+	require.Panics(t, func() { IntStringBase(1) })
+	require.Panics(t, func() { IntStringBase(63) })
+}
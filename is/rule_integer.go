@@ -0,0 +1,41 @@
+package is
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+var integerRegex = regexp.MustCompile(`^[-+]?[0-9]+$`)
+
+// Integer is a Rule that reports a violation when value is not a whole number.
+//
+// Accepted types: string (base-10 integer text, e.g. "-12") and all numeric
+// types supported by ishelper.ToRat (floats must have no fractional part).
+// Unsupported types, fractional values, NaN and infinities produce ViolationInteger.
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+var Integer Rule = WithSpec(RuleSpec{
+	Name:  "Integer",
+	Code:  ViolationInteger,
+	Kinds: []Kind{KindString, KindNumber},
+}, func(_ context.Context, value any) *Violation {
+	resolved, skip := ishelper.ExtractOptional(value)
+	if skip {
+		return nil
+	}
+
+	if s, ok := resolved.(string); ok {
+		if integerRegex.MatchString(s) {
+			return nil
+		}
+		return &Violation{Code: ViolationInteger, Message: FormatMessage(ViolationInteger, nil)}
+	}
+	r, ok := ishelper.ToRat(resolved)
+	if !ok || !r.IsInt() {
+		return &Violation{Code: ViolationInteger, Message: FormatMessage(ViolationInteger, nil)}
+	}
+	return nil
+})
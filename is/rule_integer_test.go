@@ -0,0 +1,32 @@
+package is
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInteger(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	require.Nil(t, Integer(ctx, 3))
+	require.Nil(t, Integer(ctx, uint8(3)))
+	require.Nil(t, Integer(ctx, 3.0))
+	require.Nil(t, Integer(ctx, "-12"))
+	require.Nil(t, Integer(ctx, json.Number("7")))
+	require.Nil(t, Integer(ctx, big.NewRat(10, 5)))
+	require.Equal(t, ViolationInteger, Integer(ctx, 3.5).Code)
+	require.Equal(t, ViolationInteger, Integer(ctx, math.NaN()).Code)
+	require.Equal(t, ViolationInteger, Integer(ctx, "1.0").Code)
+	require.Equal(t, ViolationInteger, Integer(ctx, "abc").Code)
+	require.Equal(t, ViolationInteger, Integer(ctx, big.NewRat(1, 2)).Code)
+	require.Equal(t, ViolationInteger, Integer(ctx, nil).Code)
+	require.Nil(t, Integer(ctx, ishelper.None[float64]()))
+	require.Equal(t, ViolationInteger, Integer(ctx, ishelper.Some(0.5)).Code)
+}
@@ -0,0 +1,34 @@
+package is
+
+import (
+	"context"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// ISO3166Alpha2 is a Rule that reports a violation when value is not an
+// ISO 3166-1 alpha-2 country code listed in Countries (e.g. "FR").
+//
+// Accepted type: string (uppercase).
+// Unsupported types and unknown codes produce ViolationCountry.
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+var ISO3166Alpha2 Rule = WithSpec(RuleSpec{
+	Name:  "ISO3166Alpha2",
+	Code:  ViolationCountry,
+	Kinds: []Kind{KindString},
+}, func(_ context.Context, value any) *Violation {
+	resolved, skip := ishelper.ExtractOptional(value)
+	if skip {
+		return nil
+	}
+	s, ok := resolved.(string)
+	if !ok {
+		return &Violation{Code: ViolationCountry, Message: FormatMessage(ViolationCountry, nil)}
+	}
+	if _, known := Countries[s]; !known {
+		return &Violation{Code: ViolationCountry, Message: FormatMessage(ViolationCountry, nil)}
+	}
+	return nil
+})
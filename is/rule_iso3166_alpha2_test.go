@@ -0,0 +1,23 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestISO3166Alpha2(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	require.Nil(t, ISO3166Alpha2(ctx, "FR"))
+	require.Nil(t, ISO3166Alpha2(ctx, "US"))
+	require.Equal(t, ViolationCountry, ISO3166Alpha2(ctx, "fr").Code)
+	require.Equal(t, ViolationCountry, ISO3166Alpha2(ctx, "ZZ").Code)
+	require.Equal(t, ViolationCountry, ISO3166Alpha2(ctx, "FRA").Code)
+	require.Equal(t, ViolationCountry, ISO3166Alpha2(ctx, 250).Code)
+	require.Nil(t, ISO3166Alpha2(ctx, ishelper.None[string]()))
+	require.Nil(t, ISO3166Alpha2(ctx, ishelper.Some("DE")))
+}
@@ -0,0 +1,35 @@
+package is
+
+import (
+	"context"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// ISO3166Alpha3 is a Rule that reports a violation when value is not an
+// ISO 3166-1 alpha-3 country code listed in Countries (e.g. "FRA").
+//
+// Accepted type: string (uppercase).
+// Unsupported types and unknown codes produce ViolationCountry.
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+var ISO3166Alpha3 Rule = WithSpec(RuleSpec{
+	Name:  "ISO3166Alpha3",
+	Code:  ViolationCountry,
+	Kinds: []Kind{KindString},
+}, func(_ context.Context, value any) *Violation {
+	resolved, skip := ishelper.ExtractOptional(value)
+	if skip {
+		return nil
+	}
+	s, ok := resolved.(string)
+	if ok && len(s) == 3 {
+		for _, alpha3 := range Countries {
+			if alpha3 == s {
+				return nil
+			}
+		}
+	}
+	return &Violation{Code: ViolationCountry, Message: FormatMessage(ViolationCountry, nil)}
+})
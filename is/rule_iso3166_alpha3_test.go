@@ -0,0 +1,23 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestISO3166Alpha3(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	require.Nil(t, ISO3166Alpha3(ctx, "FRA"))
+	require.Nil(t, ISO3166Alpha3(ctx, "USA"))
+	require.Equal(t, ViolationCountry, ISO3166Alpha3(ctx, "fra").Code)
+	require.Equal(t, ViolationCountry, ISO3166Alpha3(ctx, "ZZZ").Code)
+	require.Equal(t, ViolationCountry, ISO3166Alpha3(ctx, "FR").Code)
+	require.Equal(t, ViolationCountry, ISO3166Alpha3(ctx, nil).Code)
+	require.Nil(t, ISO3166Alpha3(ctx, ishelper.None[string]()))
+	require.Nil(t, ISO3166Alpha3(ctx, ishelper.Some("DEU")))
+}
@@ -0,0 +1,34 @@
+package is
+
+import (
+	"context"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// ISO4217Currency is a Rule that reports a violation when value is not an
+// active ISO 4217 alphabetic currency code listed in Currencies.
+//
+// Accepted type: string (uppercase, e.g. "EUR").
+// Unsupported types and unknown codes produce ViolationCurrency.
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+var ISO4217Currency Rule = WithSpec(RuleSpec{
+	Name:  "ISO4217Currency",
+	Code:  ViolationCurrency,
+	Kinds: []Kind{KindString},
+}, func(_ context.Context, value any) *Violation {
+	resolved, skip := ishelper.ExtractOptional(value)
+	if skip {
+		return nil
+	}
+	s, ok := resolved.(string)
+	if !ok {
+		return &Violation{Code: ViolationCurrency, Message: FormatMessage(ViolationCurrency, nil)}
+	}
+	if _, known := Currencies[s]; !known {
+		return &Violation{Code: ViolationCurrency, Message: FormatMessage(ViolationCurrency, nil)}
+	}
+	return nil
+})
@@ -0,0 +1,22 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestISO4217Currency(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	require.Nil(t, ISO4217Currency(ctx, "EUR"))
+	require.Nil(t, ISO4217Currency(ctx, "JPY"))
+	require.Equal(t, ViolationCurrency, ISO4217Currency(ctx, "eur").Code)
+	require.Equal(t, ViolationCurrency, ISO4217Currency(ctx, "XYZ").Code)
+	require.Equal(t, ViolationCurrency, ISO4217Currency(ctx, 978).Code)
+	require.Nil(t, ISO4217Currency(ctx, ishelper.None[string]()))
+	require.Nil(t, ISO4217Currency(ctx, ishelper.Some("USD")))
+}
@@ -0,0 +1,31 @@
+package is
+
+import (
+	"context"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// ISO639Language is a Rule that reports a violation when value is not an
+// ISO 639-1 two-letter language code listed in Languages (e.g. "en").
+//
+// Accepted type: string (lowercase).
+// Unsupported types and unknown codes produce ViolationLanguage.
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+var ISO639Language Rule = WithSpec(RuleSpec{
+	Name:  "ISO639Language",
+	Code:  ViolationLanguage,
+	Kinds: []Kind{KindString},
+}, func(_ context.Context, value any) *Violation {
+	resolved, skip := ishelper.ExtractOptional(value)
+	if skip {
+		return nil
+	}
+	s, ok := resolved.(string)
+	if !ok || !Languages[s] {
+		return &Violation{Code: ViolationLanguage, Message: FormatMessage(ViolationLanguage, nil)}
+	}
+	return nil
+})
@@ -0,0 +1,23 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestISO639Language(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	require.Nil(t, ISO639Language(ctx, "en"))
+	require.Nil(t, ISO639Language(ctx, "zh"))
+	require.Equal(t, ViolationLanguage, ISO639Language(ctx, "EN").Code)
+	require.Equal(t, ViolationLanguage, ISO639Language(ctx, "xx").Code)
+	require.Equal(t, ViolationLanguage, ISO639Language(ctx, "eng").Code)
+	require.Equal(t, ViolationLanguage, ISO639Language(ctx, 1).Code)
+	require.Nil(t, ISO639Language(ctx, ishelper.None[string]()))
+	require.Nil(t, ISO639Language(ctx, ishelper.Some("fr")))
+}
@@ -0,0 +1,44 @@
+package is
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// JSON is a Rule that reports a violation when value is not well-formed JSON.
+//
+// Accepted types: string, []byte, json.RawMessage.
+// Unsupported types and malformed documents produce ViolationJSON.
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+var JSON Rule = WithSpec(RuleSpec{
+	Name:  "JSON",
+	Code:  ViolationJSON,
+	Kinds: []Kind{KindString, KindBytes},
+}, func(_ context.Context, value any) *Violation {
+	resolved, skip := ishelper.ExtractOptional(value)
+	if skip {
+		return nil
+	}
+	b, ok := jsonBytes(resolved)
+	if !ok || !json.Valid(b) {
+		return &Violation{Code: ViolationJSON, Message: FormatMessage(ViolationJSON, nil)}
+	}
+	return nil
+})
+
+func jsonBytes(value any) ([]byte, bool) {
+	switch v := value.(type) {
+	case string:
+		return []byte(v), true
+	case []byte:
+		return v, true
+	case json.RawMessage:
+		return v, true
+	default:
+		return nil, false
+	}
+}
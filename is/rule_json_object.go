@@ -0,0 +1,38 @@
+package is
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// JSONObject is a Rule that reports a violation when value is not a
+// well-formed JSON document whose top-level value is an object.
+//
+// Accepted types: string, []byte, json.RawMessage.
+// Unsupported types, malformed documents and non-object documents
+// (arrays, strings, numbers, null, ...) produce ViolationJSONObject.
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+var JSONObject Rule = WithSpec(RuleSpec{
+	Name:  "JSONObject",
+	Code:  ViolationJSONObject,
+	Kinds: []Kind{KindString, KindBytes},
+}, func(_ context.Context, value any) *Violation {
+	resolved, skip := ishelper.ExtractOptional(value)
+	if skip {
+		return nil
+	}
+	b, ok := jsonBytes(resolved)
+	if !ok || !isJSONObject(b) {
+		return &Violation{Code: ViolationJSONObject, Message: FormatMessage(ViolationJSONObject, nil)}
+	}
+	return nil
+})
+
+func isJSONObject(b []byte) bool {
+	return json.Valid(b) && bytes.HasPrefix(bytes.TrimLeft(b, " \t\r\n"), []byte("{"))
+}
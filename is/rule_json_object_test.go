@@ -0,0 +1,25 @@
+package is
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONObject(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	require.Nil(t, JSONObject(ctx, `{"a": 1}`))
+	require.Nil(t, JSONObject(ctx, " \n{}"))
+	require.Nil(t, JSONObject(ctx, json.RawMessage(`{}`)))
+	require.Equal(t, ViolationJSONObject, JSONObject(ctx, `[{}]`).Code)
+	require.Equal(t, ViolationJSONObject, JSONObject(ctx, `null`).Code)
+	require.Equal(t, ViolationJSONObject, JSONObject(ctx, `{`).Code)
+	require.Equal(t, ViolationJSONObject, JSONObject(ctx, map[string]any{}).Code)
+	require.Nil(t, JSONObject(ctx, ishelper.None[string]()))
+	require.Equal(t, ViolationJSONObject, JSONObject(ctx, ishelper.Some("1")).Code)
+}
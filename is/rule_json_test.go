@@ -0,0 +1,25 @@
+package is
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSON(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	require.Nil(t, JSON(ctx, `{"a": [1, 2]}`))
+	require.Nil(t, JSON(ctx, `"str"`))
+	require.Nil(t, JSON(ctx, []byte(`null`)))
+	require.Nil(t, JSON(ctx, json.RawMessage(`[1]`)))
+	require.Equal(t, ViolationJSON, JSON(ctx, `{"a":}`).Code)
+	require.Equal(t, ViolationJSON, JSON(ctx, ``).Code)
+	require.Equal(t, ViolationJSON, JSON(ctx, 1).Code)
+	require.Nil(t, JSON(ctx, ishelper.None[string]()))
+	require.Equal(t, ViolationJSON, JSON(ctx, ishelper.Some("{")).Code)
+}
@@ -0,0 +1,85 @@
+package is
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// JWTFormat returns a Rule that reports a violation when value is not
+// structurally a compact JWS JWT: three base64url segments (without padding)
+// whose header and payload decode to JSON objects and whose header carries a
+// string "alg". The signature is not verified.
+//
+// When algs is non-empty, the header "alg" must be one of them (e.g.
+// JWTFormat("RS256", "ES256") to reject "none").
+//
+// Accepted type: string.
+// Unsupported types and malformed tokens produce ViolationJWT; a disallowed
+// algorithm produces ViolationJWTAlg with params "alg" and "algs".
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+func JWTFormat(algs ...string) Rule {
+	spec := RuleSpec{
+		Name:       "JWTFormat",
+		Code:       ViolationJWT,
+		OtherCodes: []ViolationCode{ViolationJWTAlg},
+		Params:     map[string]any{"algs": anySlice(algs)},
+		Kinds:      []Kind{KindString},
+	}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
+		resolved, skip := ishelper.ExtractOptional(value)
+		if skip {
+			return nil
+		}
+
+		s, ok := resolved.(string)
+		if !ok {
+			return &Violation{Code: ViolationJWT, Message: FormatMessage(ViolationJWT, nil)}
+		}
+		alg, ok := parseJWTAlg(s)
+		if !ok {
+			return &Violation{Code: ViolationJWT, Message: FormatMessage(ViolationJWT, nil)}
+		}
+		if len(algs) > 0 && !slices.Contains(algs, alg) {
+			params := map[string]any{"alg": alg, "algs": strings.Join(algs, ", ")}
+			return &Violation{
+				Code:    ViolationJWTAlg,
+				Message: FormatMessage(ViolationJWTAlg, params),
+				Params:  params,
+			}
+		}
+		return nil
+	})
+}
+
+// parseJWTAlg checks the structure of a compact JWT and returns its header alg.
+func parseJWTAlg(s string) (string, bool) {
+	segments := strings.Split(s, ".")
+	if len(segments) != 3 || segments[0] == "" || segments[1] == "" {
+		return "", false
+	}
+	if _, err := base64.RawURLEncoding.DecodeString(segments[2]); err != nil {
+		return "", false
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(segments[1])
+	if err != nil || !isJSONObject(payload) {
+		return "", false
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(segments[0])
+	if err != nil {
+		return "", false
+	}
+	var header struct {
+		Alg *string `json:"alg"`
+	}
+	if !isJSONObject(raw) || json.Unmarshal(raw, &header) != nil || header.Alg == nil {
+		return "", false
+	}
+	return *header.Alg, true
+}
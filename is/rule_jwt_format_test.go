@@ -0,0 +1,43 @@
+package is
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func testJWT(header, payload, signature string) string {
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString([]byte(header)) + "." + enc.EncodeToString([]byte(payload)) + "." + signature
+}
+
+func TestJWTFormat(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	hs256 := testJWT(`{"alg":"HS256","typ":"JWT"}`, `{"sub":"1234567890"}`, "SflKxwRJSMeKKF2QT4fwpMeJf36POk6yJV_adQssw5c")
+	unsigned := testJWT(`{"alg":"none"}`, `{"sub":"1"}`, "")
+
+	rule := JWTFormat()
+	require.Nil(t, rule(ctx, hs256))
+	require.Nil(t, rule(ctx, unsigned))
+	require.Equal(t, ViolationJWT, rule(ctx, "a.b").Code)
+	require.Equal(t, ViolationJWT, rule(ctx, "a.b.c.d").Code)
+	require.Equal(t, ViolationJWT, rule(ctx, testJWT(`{"typ":"JWT"}`, `{}`, "")).Code)
+	require.Equal(t, ViolationJWT, rule(ctx, testJWT(`{"alg":1}`, `{}`, "")).Code)
+	require.Equal(t, ViolationJWT, rule(ctx, testJWT(`[]`, `{}`, "")).Code)
+	require.Equal(t, ViolationJWT, rule(ctx, testJWT(`{"alg":"HS256"}`, `not json`, "")).Code)
+	require.Equal(t, ViolationJWT, rule(ctx, testJWT(`{"alg":"HS256"}`, `{}`, "***")).Code)
+	require.Equal(t, ViolationJWT, rule(ctx, 42).Code)
+	require.Nil(t, rule(ctx, ishelper.None[string]()))
+	require.Nil(t, rule(ctx, ishelper.Some(hs256)))
+
+	strict := JWTFormat("HS256", "RS256")
+	require.Nil(t, strict(ctx, hs256))
+	got := strict(ctx, unsigned)
+	require.Equal(t, ViolationJWTAlg, got.Code)
+	require.Equal(t, map[string]any{"alg": "none", "algs": "HS256, RS256"}, got.Params)
+}
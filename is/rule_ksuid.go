@@ -0,0 +1,38 @@
+package is
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+var ksuidRegex = regexp.MustCompile(`^[0-9A-Za-z]{27}$`)
+
+// maxKSUID is the base62 encoding of the largest 160-bit KSUID. Base62 digits
+// sort like their ASCII bytes, so fixed-length strings compare numerically.
+const maxKSUID = "aWgEPTl1tmebfsQzFP4bxwgy80V"
+
+// KSUID is a Rule that reports a violation when value is not a KSUID.
+//
+// Accepted type: string (27 base62 characters, at most "aWgEPTl1tmebfsQzFP4bxwgy80V").
+// Unsupported types and non-matching text produce ViolationKSUID.
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+var KSUID Rule = WithSpec(RuleSpec{
+	Name:   "KSUID",
+	Code:   ViolationKSUID,
+	Params: map[string]any{"pattern": ksuidRegex.String()},
+	Kinds:  []Kind{KindString},
+}, func(_ context.Context, value any) *Violation {
+	resolved, skip := ishelper.ExtractOptional(value)
+	if skip {
+		return nil
+	}
+	s, ok := resolved.(string)
+	if !ok || !ksuidRegex.MatchString(s) || s > maxKSUID {
+		return &Violation{Code: ViolationKSUID, Message: FormatMessage(ViolationKSUID, nil)}
+	}
+	return nil
+})
@@ -0,0 +1,25 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKSUID(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	require.Nil(t, KSUID(ctx, "0ujtsYcgvSTl8PAuAdqWYSMnLOv"))
+	require.Nil(t, KSUID(ctx, "000000000000000000000000000"))
+	require.Nil(t, KSUID(ctx, "aWgEPTl1tmebfsQzFP4bxwgy80V"))
+	require.Equal(t, ViolationKSUID, KSUID(ctx, "aWgEPTl1tmebfsQzFP4bxwgy80W").Code)
+	require.Equal(t, ViolationKSUID, KSUID(ctx, "zzzzzzzzzzzzzzzzzzzzzzzzzzz").Code)
+	require.Equal(t, ViolationKSUID, KSUID(ctx, "0ujtsYcgvSTl8PAuAdqWYSMnLO").Code)
+	require.Equal(t, ViolationKSUID, KSUID(ctx, "0ujtsYcgvSTl8PAuAdqWYSMnLO-").Code)
+	require.Equal(t, ViolationKSUID, KSUID(ctx, nil).Code)
+	require.Nil(t, KSUID(ctx, ishelper.None[string]()))
+	require.Nil(t, KSUID(ctx, ishelper.Some("0ujtsYcgvSTl8PAuAdqWYSMnLOv")))
+}
@@ -0,0 +1,35 @@
+package is
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+var latitudeLimit = big.NewRat(90, 1)
+
+// Latitude is a Rule that reports a violation when value is not a latitude in
+// decimal degrees, i.e. within [-90, 90].
+//
+// Accepted types: all numeric types supported by ishelper.ToRat. Wrap with
+// ParsedNumber to validate coordinates received as strings.
+// Unsupported/non-numeric values produce ViolationLatitude.
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+var Latitude Rule = WithSpec(RuleSpec{
+	Name:  "Latitude",
+	Code:  ViolationLatitude,
+	Kinds: []Kind{KindNumber},
+}, func(_ context.Context, value any) *Violation {
+	resolved, skip := ishelper.ExtractOptional(value)
+	if skip {
+		return nil
+	}
+	n, ok := ishelper.ToRat(resolved)
+	if !ok || new(big.Rat).Abs(n).Cmp(latitudeLimit) > 0 {
+		return &Violation{Code: ViolationLatitude, Message: FormatMessage(ViolationLatitude, nil)}
+	}
+	return nil
+})
@@ -0,0 +1,24 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLatitude(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	require.Nil(t, Latitude(ctx, 48.8566))
+	require.Nil(t, Latitude(ctx, -90))
+	require.Nil(t, Latitude(ctx, 90.0))
+	require.Equal(t, ViolationLatitude, Latitude(ctx, 90.0001).Code)
+	require.Equal(t, ViolationLatitude, Latitude(ctx, -91).Code)
+	require.Equal(t, ViolationLatitude, Latitude(ctx, "48.85").Code)
+	require.Nil(t, ParsedNumber(Latitude)(ctx, "48.85"))
+	require.Nil(t, Latitude(ctx, ishelper.None[float64]()))
+	require.Equal(t, ViolationLatitude, Latitude(ctx, ishelper.Some(100.0)).Code)
+}
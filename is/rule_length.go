@@ -15,7 +15,13 @@ import (
 // Optional behavior: None -> nil (absent field skips the constraint);
 // Some(v) -> validates the unwrapped value.
 func Length(min, max int) Rule {
-	return func(_ context.Context, value any) *Violation {
+	spec := RuleSpec{
+		Name:   "Length",
+		Code:   ViolationLength,
+		Params: map[string]any{"min": min, "max": max},
+		Kinds:  []Kind{KindString, KindList, KindMap},
+	}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
 		resolved, skip := ishelper.ExtractOptional(value)
 		if skip {
 			return nil
@@ -24,7 +30,7 @@ func Length(min, max int) Rule {
 		if resolved == nil {
 			return &Violation{
 				Code:    ViolationLength,
-				Message: formatMessage(ViolationLength, map[string]any{"min": min, "max": max}),
+				Message: FormatMessage(ViolationLength, map[string]any{"min": min, "max": max}),
 			}
 		}
 
@@ -35,15 +41,15 @@ func Length(min, max int) Rule {
 			if l < min || l > max {
 				return &Violation{
 					Code:    ViolationLength,
-					Message: formatMessage(ViolationLength, map[string]any{"min": min, "max": max}),
+					Message: FormatMessage(ViolationLength, map[string]any{"min": min, "max": max}),
 				}
 			}
 			return nil
 		default:
 			return &Violation{
 				Code:    ViolationLength,
-				Message: formatMessage(ViolationLength, map[string]any{"min": min, "max": max}),
+				Message: FormatMessage(ViolationLength, map[string]any{"min": min, "max": max}),
 			}
 		}
-	}
+	})
 }
@@ -0,0 +1,85 @@
+package is
+
+import (
+	"context"
+	"unicode/utf8"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// LengthUnit selects how LengthIn, MinLengthIn and MaxLengthIn measure a string.
+type LengthUnit int
+
+const (
+	// Bytes counts UTF-8 bytes, like len(s). Use it for database column limits.
+	Bytes LengthUnit = iota
+	// Runes counts Unicode code points, like utf8.RuneCountInString.
+	Runes
+	// Graphemes counts user-perceived characters (extended grapheme clusters),
+	// so "é" written as e + U+0301 and "👨‍👩‍👧" each count as one.
+	Graphemes
+	// UTF16 counts UTF-16 code units, matching JavaScript's string length.
+	UTF16
+	// Width counts terminal columns (see ishelper.DisplayWidth): East Asian
+	// wide and fullwidth characters and emoji count as two, combining marks
+	// as zero. Use it for fixed-width displays such as receipts or CLIs.
+	Width
+)
+
+func (u LengthUnit) count(s string) int {
+	switch u {
+	case Runes:
+		return utf8.RuneCountInString(s)
+	case Graphemes:
+		return ishelper.GraphemeCount(s)
+	case UTF16:
+		return ishelper.UTF16Len(s)
+	case Width:
+		return ishelper.DisplayWidth(s)
+	default:
+		return len(s)
+	}
+}
+
+func (u LengthUnit) valid() bool {
+	return u >= Bytes && u <= Width
+}
+
+// LengthIn returns a Rule that reports a violation when the length of the
+// string value, measured in unit, falls outside the inclusive range [min, max].
+//
+// Accepted type: string.
+// Unsupported types produce ViolationLength.
+//
+// Unknown units panic at rule construction time.
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+func LengthIn(unit LengthUnit, min, max int) Rule {
+	if !unit.valid() {
+		panic("is.LengthIn: invalid unit")
+	}
+
+	spec := RuleSpec{
+		Name:   "LengthIn",
+		Code:   ViolationLength,
+		Params: map[string]any{"unit": unit, "min": min, "max": max},
+		Kinds:  []Kind{KindString},
+	}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
+		resolved, skip := ishelper.ExtractOptional(value)
+		if skip {
+			return nil
+		}
+
+		s, ok := resolved.(string)
+		if ok {
+			if l := unit.count(s); l >= min && l <= max {
+				return nil
+			}
+		}
+		return &Violation{
+			Code:    ViolationLength,
+			Message: FormatMessage(ViolationLength, map[string]any{"min": min, "max": max}),
+		}
+	})
+}
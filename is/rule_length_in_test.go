@@ -0,0 +1,53 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLengthIn(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		value string
+		want  map[LengthUnit]int
+	}{
+		{name: "ascii", value: "hello", want: map[LengthUnit]int{Bytes: 5, Runes: 5, Graphemes: 5, UTF16: 5, Width: 5}},
+		{name: "precomposed accent", value: "héllo", want: map[LengthUnit]int{Bytes: 6, Runes: 5, Graphemes: 5, UTF16: 5, Width: 5}},
+		{name: "combining accent", value: "he\u0301llo", want: map[LengthUnit]int{Bytes: 7, Runes: 6, Graphemes: 5, UTF16: 6, Width: 5}},
+		{name: "emoji", value: "😀", want: map[LengthUnit]int{Bytes: 4, Runes: 1, Graphemes: 1, UTF16: 2, Width: 2}},
+		{name: "skin tone", value: "👍🏽", want: map[LengthUnit]int{Bytes: 8, Runes: 2, Graphemes: 1, UTF16: 4, Width: 2}},
+		{name: "zwj family", value: "\U0001F468\u200d\U0001F469\u200d\U0001F467", want: map[LengthUnit]int{Runes: 5, Graphemes: 1, UTF16: 8, Width: 6}},
+		{name: "flags", value: "🇫🇷🇩🇪", want: map[LengthUnit]int{Runes: 4, Graphemes: 2, UTF16: 8}},
+		{name: "odd regional indicators", value: "🇫🇷🇩", want: map[LengthUnit]int{Graphemes: 2}},
+		{name: "hangul jamo", value: "\u1100\u1161\u11a8", want: map[LengthUnit]int{Runes: 3, Graphemes: 1, Width: 2}},
+		{name: "hangul syllables", value: "한국어", want: map[LengthUnit]int{Runes: 3, Graphemes: 3, Width: 6}},
+		{name: "crlf", value: "a\r\nb", want: map[LengthUnit]int{Runes: 4, Graphemes: 3, Width: 2}},
+		{name: "heart with variation selector", value: "❤️", want: map[LengthUnit]int{Runes: 2, Graphemes: 1}},
+		{name: "keycap", value: "1️⃣", want: map[LengthUnit]int{Runes: 3, Graphemes: 1}},
+		{name: "devanagari spacing mark", value: "कि", want: map[LengthUnit]int{Runes: 2, Graphemes: 1, Width: 2}},
+		{name: "fullwidth and cjk", value: "abＡ漢字", want: map[LengthUnit]int{Runes: 5, Width: 8}},
+	}
+
+	ctx := context.Background()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			for unit, n := range tt.want {
+				require.Nil(t, LengthIn(unit, n, n)(ctx, tt.value), "LengthIn(%d, %d, %d)(%q)", unit, n, n, tt.value)
+				require.Equal(t, ViolationLength, LengthIn(unit, n+1, n+1)(ctx, tt.value).Code, "LengthIn(%d, %d, %d)(%q)", unit, n+1, n+1, tt.value)
+			}
+		})
+	}
+
+	require.Equal(t, ViolationLength, LengthIn(Runes, 1, 3)(ctx, []string{"a"}).Code)
+	require.Equal(t, ViolationLength, LengthIn(Runes, 1, 3)(ctx, nil).Code)
+	require.Nil(t, LengthIn(Runes, 1, 3)(ctx, ishelper.None[string]()))
+	require.Equal(t, ViolationLength, LengthIn(Runes, 1, 3)(ctx, ishelper.Some("abcd")).Code)
+	require.Panics(t, func() { LengthIn(LengthUnit(42), 1, 3) })
+}
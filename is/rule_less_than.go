@@ -7,7 +7,7 @@ import (
 
 // LessThan returns a Rule that reports a violation when value is >= limit.
 //
-// Accepted types: all types in ishelper.Number (signed/unsigned integers, float32, float64).
+// Accepted types: all numeric types supported by ishelper.ToRat.
 // Unsupported/non-numeric values produce ViolationLT.
 //
 // Optional behaviour: None -> nil (absent field skips the constraint);
@@ -18,7 +18,13 @@ func LessThan[T ishelper.Number](limit T) Rule {
 		panic("is.LessThan: invalid limit value")
 	}
 
-	return func(_ context.Context, value any) *Violation {
+	spec := RuleSpec{
+		Name:   "LessThan",
+		Code:   ViolationLT,
+		Params: map[string]any{"value": limit},
+		Kinds:  []Kind{KindNumber},
+	}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
 		resolved, skip := ishelper.ExtractOptional(value)
 		if skip {
 			return nil
@@ -27,9 +33,9 @@ func LessThan[T ishelper.Number](limit T) Rule {
 		if !ok || n.Cmp(boundary) >= 0 {
 			return &Violation{
 				Code:    ViolationLT,
-				Message: formatMessage(ViolationLT, map[string]any{"value": limit}),
+				Message: FormatMessage(ViolationLT, map[string]any{"value": limit}),
 			}
 		}
 		return nil
-	}
+	})
 }
@@ -7,7 +7,7 @@ import (
 
 // LessThanOrEqual returns a Rule that reports a violation when value is > limit.
 //
-// Accepted types: all types in ishelper.Number (signed/unsigned integers, float32, float64).
+// Accepted types: all numeric types supported by ishelper.ToRat.
 // Unsupported/non-numeric values produce ViolationLTE.
 //
 // Optional behaviour: None -> nil (absent field skips the constraint);
@@ -18,7 +18,13 @@ func LessThanOrEqual[T ishelper.Number](limit T) Rule {
 		panic("is.LessThanOrEqual: invalid limit value")
 	}
 
-	return func(_ context.Context, value any) *Violation {
+	spec := RuleSpec{
+		Name:   "LessThanOrEqual",
+		Code:   ViolationLTE,
+		Params: map[string]any{"value": limit},
+		Kinds:  []Kind{KindNumber},
+	}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
 		resolved, skip := ishelper.ExtractOptional(value)
 		if skip {
 			return nil
@@ -27,9 +33,9 @@ func LessThanOrEqual[T ishelper.Number](limit T) Rule {
 		if !ok || n.Cmp(boundary) > 0 {
 			return &Violation{
 				Code:    ViolationLTE,
-				Message: formatMessage(ViolationLTE, map[string]any{"value": limit}),
+				Message: FormatMessage(ViolationLTE, map[string]any{"value": limit}),
 			}
 		}
 		return nil
-	}
+	})
 }
@@ -0,0 +1,18 @@
+package is
+
+import "unicode"
+
+// Letters is a Rule that reports a violation when the string value contains
+// anything other than Unicode letters and combining marks. Unlike Alpha,
+// accented names such as "José" or "Zoë" are accepted.
+//
+// Accepted type: string. The empty string is accepted.
+// Unsupported types and strings with other characters produce ViolationLetters.
+// Violation params: "char" (first offending character) and "position"
+// (its 0-based rune index).
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+var Letters Rule = charsetRule("Letters", ViolationLetters, func(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsMark(r)
+})
@@ -0,0 +1,17 @@
+package is
+
+import "unicode"
+
+// LettersAndDigits is a Rule that reports a violation when the string value contains
+// anything other than Unicode letters, combining marks and decimal digits.
+//
+// Accepted type: string. The empty string is accepted.
+// Unsupported types and strings with other characters produce ViolationLettersDigits.
+// Violation params: "char" (first offending character) and "position"
+// (its 0-based rune index).
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+var LettersAndDigits Rule = charsetRule("LettersAndDigits", ViolationLettersDigits, func(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsMark(r) || unicode.Is(unicode.Nd, r)
+})
@@ -0,0 +1,25 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLettersAndDigits(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	require.Nil(t, LettersAndDigits(ctx, "José42"))
+	require.Nil(t, LettersAndDigits(ctx, "٣٤Zoë"))
+
+	got := LettersAndDigits(ctx, "abc-1")
+	require.Equal(t, ViolationLettersDigits, got.Code)
+	require.Equal(t, map[string]any{"char": "-", "position": 3}, got.Params)
+	require.Equal(t, ViolationLettersDigits, LettersAndDigits(ctx, "½").Code)
+	require.Equal(t, ViolationLettersDigits, LettersAndDigits(ctx, ishelper.Some("a b")).Code)
+	require.Equal(t, ViolationLettersDigits, LettersAndDigits(ctx, 42).Code)
+	require.Nil(t, LettersAndDigits(ctx, ishelper.None[string]()))
+}
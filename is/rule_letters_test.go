@@ -0,0 +1,28 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLetters(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	require.Nil(t, Letters(ctx, "José"))
+	require.Nil(t, Letters(ctx, "Zoë"))
+	require.Nil(t, Letters(ctx, "Zoe\u0308"))
+	require.Nil(t, Letters(ctx, "Дмитрий"))
+	require.Nil(t, Letters(ctx, ""))
+
+	got := Letters(ctx, "Jean Luc")
+	require.Equal(t, ViolationLetters, got.Code)
+	require.Equal(t, map[string]any{"char": " ", "position": 4}, got.Params)
+	require.Equal(t, 3, Letters(ctx, "Zoë1").Params["position"])
+	require.Equal(t, ViolationLetters, Letters(ctx, ishelper.Some("R2D2")).Code)
+	require.Equal(t, ViolationLetters, Letters(ctx, 42).Code)
+	require.Nil(t, Letters(ctx, ishelper.None[string]()))
+}
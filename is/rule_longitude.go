@@ -0,0 +1,35 @@
+package is
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+var longitudeLimit = big.NewRat(180, 1)
+
+// Longitude is a Rule that reports a violation when value is not a longitude
+// in decimal degrees, i.e. within [-180, 180].
+//
+// Accepted types: all numeric types supported by ishelper.ToRat. Wrap with
+// ParsedNumber to validate coordinates received as strings.
+// Unsupported/non-numeric values produce ViolationLongitude.
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+var Longitude Rule = WithSpec(RuleSpec{
+	Name:  "Longitude",
+	Code:  ViolationLongitude,
+	Kinds: []Kind{KindNumber},
+}, func(_ context.Context, value any) *Violation {
+	resolved, skip := ishelper.ExtractOptional(value)
+	if skip {
+		return nil
+	}
+	n, ok := ishelper.ToRat(resolved)
+	if !ok || new(big.Rat).Abs(n).Cmp(longitudeLimit) > 0 {
+		return &Violation{Code: ViolationLongitude, Message: FormatMessage(ViolationLongitude, nil)}
+	}
+	return nil
+})
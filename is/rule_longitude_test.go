@@ -0,0 +1,23 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLongitude(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	require.Nil(t, Longitude(ctx, 2.3522))
+	require.Nil(t, Longitude(ctx, -180))
+	require.Nil(t, Longitude(ctx, 180.0))
+	require.Equal(t, ViolationLongitude, Longitude(ctx, 180.5).Code)
+	require.Equal(t, ViolationLongitude, Longitude(ctx, -181).Code)
+	require.Equal(t, ViolationLongitude, Longitude(ctx, "2.35").Code)
+	require.Nil(t, Longitude(ctx, ishelper.None[float64]()))
+	require.Equal(t, ViolationLongitude, Longitude(ctx, ishelper.Some(200)).Code)
+}
@@ -0,0 +1,25 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLowercase(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	require.Nil(t, Lowercase(ctx, "hello-world_42"))
+	require.Nil(t, Lowercase(ctx, "élan"))
+
+	got := Lowercase(ctx, "helLo")
+	require.Equal(t, ViolationLowercase, got.Code)
+	require.Equal(t, map[string]any{"char": "L", "position": 3}, got.Params)
+	require.Equal(t, ViolationLowercase, Lowercase(ctx, "Élan").Code)
+	require.Equal(t, ViolationLowercase, Lowercase(ctx, ishelper.Some("ǅ")).Code)
+	require.Equal(t, ViolationLowercase, Lowercase(ctx, 42).Code)
+	require.Nil(t, Lowercase(ctx, ishelper.None[string]()))
+}
@@ -0,0 +1,62 @@
+package is
+
+import (
+	"context"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// Luhn is a Rule that reports a violation when value does not pass the Luhn
+// (mod 10) checksum used by card numbers, IMEIs and many national IDs.
+//
+// Accepted type: string of at least two ASCII digits, without separators.
+// Unsupported types, other characters and bad checksums produce ViolationLuhn.
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+var Luhn Rule = WithSpec(RuleSpec{
+	Name:  "Luhn",
+	Code:  ViolationLuhn,
+	Kinds: []Kind{KindString},
+}, func(_ context.Context, value any) *Violation {
+	resolved, skip := ishelper.ExtractOptional(value)
+	if skip {
+		return nil
+	}
+	s, ok := resolved.(string)
+	if !ok || len(s) < 2 || !isDigits(s) || !luhnValid(s) {
+		return &Violation{Code: ViolationLuhn, Message: FormatMessage(ViolationLuhn, nil)}
+	}
+	return nil
+})
+
+// luhnValid reports whether the ASCII digit string s has a valid Luhn checksum.
+func luhnValid(s string) bool {
+	sum := 0
+	double := false
+	for i := len(s) - 1; i >= 0; i-- {
+		d := int(s[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// isDigits reports whether s is non-empty and only contains ASCII digits.
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
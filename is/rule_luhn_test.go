@@ -0,0 +1,23 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLuhn(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	require.Nil(t, Luhn(ctx, "79927398713"))
+	require.Nil(t, Luhn(ctx, "4111111111111111"))
+	require.Equal(t, ViolationLuhn, Luhn(ctx, "79927398710").Code)
+	require.Equal(t, ViolationLuhn, Luhn(ctx, "4111 1111 1111 1111").Code)
+	require.Equal(t, ViolationLuhn, Luhn(ctx, "0").Code)
+	require.Equal(t, ViolationLuhn, Luhn(ctx, 79927398713).Code)
+	require.Nil(t, Luhn(ctx, ishelper.None[string]()))
+	require.Equal(t, ViolationLuhn, Luhn(ctx, ishelper.Some("12")).Code)
+}
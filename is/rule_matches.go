@@ -19,7 +19,13 @@ func Matches(pattern string) Rule {
 	if err != nil {
 		panic("is.Matches: invalid pattern")
 	}
-	return func(_ context.Context, value any) *Violation {
+	spec := RuleSpec{
+		Name:   "Matches",
+		Code:   ViolationMatches,
+		Params: map[string]any{"pattern": pattern},
+		Kinds:  []Kind{KindString},
+	}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
 		resolved, skip := ishelper.ExtractOptional(value)
 		if skip {
 			return nil
@@ -28,9 +34,9 @@ func Matches(pattern string) Rule {
 		if !ok || !re.MatchString(s) {
 			return &Violation{
 				Code:    ViolationMatches,
-				Message: formatMessage(ViolationMatches, map[string]any{"pattern": pattern}),
+				Message: FormatMessage(ViolationMatches, map[string]any{"pattern": pattern}),
 			}
 		}
 		return nil
-	}
+	})
 }
@@ -8,7 +8,7 @@ import (
 // Max returns a Rule that reports a violation when the numeric value is strictly
 // greater than max.
 //
-// Accepted types: ishelper.Number (signed/unsigned integers, float32, float64).
+// Accepted types: all numeric types supported by ishelper.ToRat.
 // Unsupported/non-numeric values produce ViolationMax.
 //
 // Optional behavior: None -> nil (absent field skips the constraint);
@@ -19,7 +19,13 @@ func Max[T ishelper.Number](max T) Rule {
 		panic("is.Max: invalid max value")
 	}
 
-	return func(_ context.Context, value any) *Violation {
+	spec := RuleSpec{
+		Name:   "Max",
+		Code:   ViolationMax,
+		Params: map[string]any{"max": max},
+		Kinds:  []Kind{KindNumber},
+	}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
 		resolved, skip := ishelper.ExtractOptional(value)
 		if skip {
 			return nil
@@ -29,9 +35,9 @@ func Max[T ishelper.Number](max T) Rule {
 		if !ok || n.Cmp(limit) > 0 {
 			return &Violation{
 				Code:    ViolationMax,
-				Message: formatMessage(ViolationMax, map[string]any{"max": max}),
+				Message: FormatMessage(ViolationMax, map[string]any{"max": max}),
 			}
 		}
 		return nil
-	}
+	})
 }
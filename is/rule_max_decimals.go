@@ -0,0 +1,48 @@
+package is
+
+import (
+	"context"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// MaxDecimals returns a Rule that reports a violation when the numeric value
+// has more than n digits after the decimal point.
+//
+// Accepted types: all numeric types supported by ishelper.ToRat.
+// Floats are read through their shortest decimal representation, so 0.1 has
+// one decimal. Values without a finite decimal expansion (e.g. big.NewRat(1, 3))
+// and unsupported/non-numeric values produce ViolationMaxDecimals.
+//
+// n must be >= 0. Invalid n panics at rule construction time.
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+func MaxDecimals(n int) Rule {
+	if n < 0 {
+		panic("is.MaxDecimals: n must be >= 0")
+	}
+
+	spec := RuleSpec{
+		Name:   "MaxDecimals",
+		Code:   ViolationMaxDecimals,
+		Params: map[string]any{"max": n},
+		Kinds:  []Kind{KindNumber},
+	}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
+		resolved, skip := ishelper.ExtractOptional(value)
+		if skip {
+			return nil
+		}
+
+		r, ok := ishelper.ToDecimalRat(resolved)
+		if ok {
+			if d, finite := ishelper.Decimals(r); finite && d <= n {
+				return nil
+			}
+		}
+		return &Violation{
+			Code:    ViolationMaxDecimals,
+			Message: FormatMessage(ViolationMaxDecimals, map[string]any{"max": n}),
+		}
+	})
+}
@@ -0,0 +1,35 @@
+package is
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxDecimals(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rule := MaxDecimals(2)
+	require.Nil(t, rule(ctx, 10))
+	require.Nil(t, rule(ctx, 0.1))
+	require.Nil(t, rule(ctx, 19.99))
+	require.Nil(t, rule(ctx, float32(0.25)))
+	require.Nil(t, rule(ctx, json.Number("1.50")))
+	require.Nil(t, rule(ctx, big.NewRat(1, 4)))
+	require.Equal(t, ViolationMaxDecimals, rule(ctx, 19.999).Code)
+	require.Equal(t, ViolationMaxDecimals, rule(ctx, json.Number("0.001")).Code)
+	require.Equal(t, ViolationMaxDecimals, rule(ctx, big.NewRat(1, 3)).Code)
+	require.Equal(t, ViolationMaxDecimals, rule(ctx, "1.5").Code)
+	require.Nil(t, rule(ctx, ishelper.None[float64]()))
+	require.Equal(t, ViolationMaxDecimals, rule(ctx, ishelper.Some(1.234)).Code)
+	require.Equal(t, "must have at most 2 decimal places", rule(ctx, 1.234).Message)
+
+	require.Nil(t, MaxDecimals(0)(ctx, 42.0))
+	require.Equal(t, ViolationMaxDecimals, MaxDecimals(0)(ctx, 42.5).Code)
+	require.Panics(t, func() { MaxDecimals(-1) })
+}
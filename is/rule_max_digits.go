@@ -0,0 +1,51 @@
+package is
+
+import (
+	"context"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// MaxDigits returns a Rule that reports a violation when the numeric value does
+// not fit a SQL NUMERIC(total, fraction) column: at most fraction digits after
+// the decimal point and at most total-fraction digits before it.
+//
+// Accepted types: all numeric types supported by ishelper.ToRat.
+// Floats are read through their shortest decimal representation.
+// Values without a finite decimal expansion and unsupported/non-numeric values
+// produce ViolationMaxDigits.
+//
+// total must be > 0 and 0 <= fraction <= total. Invalid arguments panic at rule
+// construction time.
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+func MaxDigits(total, fraction int) Rule {
+	if total <= 0 || fraction < 0 || fraction > total {
+		panic("is.MaxDigits: invalid precision or scale")
+	}
+
+	spec := RuleSpec{
+		Name:   "MaxDigits",
+		Code:   ViolationMaxDigits,
+		Params: map[string]any{"total": total, "fraction": fraction},
+		Kinds:  []Kind{KindNumber},
+	}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
+		resolved, skip := ishelper.ExtractOptional(value)
+		if skip {
+			return nil
+		}
+
+		r, ok := ishelper.ToDecimalRat(resolved)
+		if ok {
+			d, finite := ishelper.Decimals(r)
+			if finite && d <= fraction && ishelper.IntegerDigits(r) <= total-fraction {
+				return nil
+			}
+		}
+		return &Violation{
+			Code:    ViolationMaxDigits,
+			Message: FormatMessage(ViolationMaxDigits, map[string]any{"total": total, "fraction": fraction}),
+		}
+	})
+}
@@ -0,0 +1,33 @@
+package is
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxDigits(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rule := MaxDigits(5, 2) // NUMERIC(5,2): -999.99 .. 999.99
+	require.Nil(t, rule(ctx, 999.99))
+	require.Nil(t, rule(ctx, -999.99))
+	require.Nil(t, rule(ctx, 0.5))
+	require.Nil(t, rule(ctx, 12))
+	require.Nil(t, rule(ctx, json.Number("100.10")))
+	require.Equal(t, ViolationMaxDigits, rule(ctx, 1000).Code)
+	require.Equal(t, ViolationMaxDigits, rule(ctx, 1.234).Code)
+	require.Equal(t, ViolationMaxDigits, rule(ctx, big.NewRat(2, 3)).Code)
+	require.Equal(t, ViolationMaxDigits, rule(ctx, "12").Code)
+	require.Nil(t, rule(ctx, ishelper.None[float64]()))
+	require.Equal(t, ViolationMaxDigits, rule(ctx, ishelper.Some(1000.0)).Code)
+
+	require.Panics(t, func() { MaxDigits(0, 0) })
+	require.Panics(t, func() { MaxDigits(2, 3) })
+	require.Panics(t, func() { MaxDigits(2, -1) })
+}
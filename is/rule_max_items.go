@@ -0,0 +1,36 @@
+package is
+
+import (
+	"context"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// MaxItems returns a Rule that reports a violation when a collection has more
+// than max elements. Unlike MaxLength, strings are not accepted.
+//
+// Accepted types: slice, array, map.
+// Unsupported types produce ViolationMaxItems.
+// Violation params: "max".
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+func MaxItems(max int) Rule {
+	spec := RuleSpec{
+		Name:   "MaxItems",
+		Code:   ViolationMaxItems,
+		Params: map[string]any{"max": max},
+		Kinds:  []Kind{KindList, KindMap},
+	}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
+		resolved, skip := ishelper.ExtractOptional(value)
+		if skip {
+			return nil
+		}
+		if n, ok := itemCount(resolved); ok && n <= max {
+			return nil
+		}
+		params := map[string]any{"max": max}
+		return &Violation{Code: ViolationMaxItems, Message: FormatMessage(ViolationMaxItems, params), Params: params}
+	})
+}
@@ -0,0 +1,29 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxItems(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rule := MaxItems(2)
+	require.Nil(t, rule(ctx, []int{1, 2}))
+	require.Nil(t, rule(ctx, []int(nil)))
+	require.Nil(t, rule(ctx, map[string]int{"a": 1}))
+
+	got := rule(ctx, []int{1, 2, 3})
+	require.Equal(t, ViolationMaxItems, got.Code)
+	require.Equal(t, "must contain at most 2 items", got.Message)
+	require.Equal(t, map[string]any{"max": 2}, got.Params)
+	got.Params["max"] = 0 // violations do not share their params
+	require.Equal(t, map[string]any{"max": 2}, rule(ctx, []int{1, 2, 3}).Params)
+	require.Equal(t, ViolationMaxItems, rule(ctx, "a").Code)
+	require.Nil(t, rule(ctx, ishelper.None[[]int]()))
+	require.Nil(t, rule(ctx, ishelper.Some([]int{1})))
+}
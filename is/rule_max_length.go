@@ -14,7 +14,13 @@ import (
 // Optional behavior: None -> nil (absent field skips the constraint);
 // Some(v) -> validates the unwrapped value.
 func MaxLength(n int) Rule {
-	return func(_ context.Context, value any) *Violation {
+	spec := RuleSpec{
+		Name:   "MaxLength",
+		Code:   ViolationMaxLength,
+		Params: map[string]any{"max": n},
+		Kinds:  []Kind{KindString, KindList, KindMap},
+	}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
 		resolved, skip := ishelper.ExtractOptional(value)
 		if skip {
 			return nil
@@ -22,7 +28,7 @@ func MaxLength(n int) Rule {
 		if resolved == nil {
 			return &Violation{
 				Code:    ViolationMaxLength,
-				Message: formatMessage(ViolationMaxLength, map[string]any{"max": n}),
+				Message: FormatMessage(ViolationMaxLength, map[string]any{"max": n}),
 			}
 		}
 
@@ -32,15 +38,15 @@ func MaxLength(n int) Rule {
 			if rv.Len() > n {
 				return &Violation{
 					Code:    ViolationMaxLength,
-					Message: formatMessage(ViolationMaxLength, map[string]any{"max": n}),
+					Message: FormatMessage(ViolationMaxLength, map[string]any{"max": n}),
 				}
 			}
 			return nil
 		default:
 			return &Violation{
 				Code:    ViolationMaxLength,
-				Message: formatMessage(ViolationMaxLength, map[string]any{"max": n}),
+				Message: FormatMessage(ViolationMaxLength, map[string]any{"max": n}),
 			}
 		}
-	}
+	})
 }
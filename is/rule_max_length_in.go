@@ -0,0 +1,49 @@
+package is
+
+import (
+	"context"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// MaxLengthIn returns a Rule that reports a violation when the length of the
+// string value, measured in unit, is > n.
+//
+// Accepted type: string.
+// Unsupported types produce ViolationMaxLength.
+//
+// Unknown units panic at rule construction time.
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+func MaxLengthIn(unit LengthUnit, n int) Rule {
+	if !unit.valid() {
+		panic("is.MaxLengthIn: invalid unit")
+	}
+
+	spec := RuleSpec{
+		Name:   "MaxLengthIn",
+		Code:   ViolationMaxLength,
+		Params: map[string]any{"unit": unit, "max": n},
+		Kinds:  []Kind{KindString},
+	}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
+		resolved, skip := ishelper.ExtractOptional(value)
+		if skip {
+			return nil
+		}
+
+		s, ok := resolved.(string)
+		if !ok || unit.count(s) > n {
+			return &Violation{
+				Code:    ViolationMaxLength,
+				Message: FormatMessage(ViolationMaxLength, map[string]any{"max": n}),
+			}
+		}
+		return nil
+	})
+}
+
+// MaxRunes is MaxLengthIn(Runes, n).
+func MaxRunes(n int) Rule {
+	return MaxLengthIn(Runes, n)
+}
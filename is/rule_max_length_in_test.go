@@ -0,0 +1,25 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxLengthIn(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	require.Nil(t, MaxRunes(5)(ctx, "héllo"))
+	require.Equal(t, ViolationMaxLength, MaxLength(5)(ctx, "héllo").Code)
+	require.Equal(t, ViolationMaxLength, MaxRunes(4)(ctx, "héllo").Code)
+	require.Nil(t, MaxLengthIn(Graphemes, 1)(ctx, "\U0001F468\u200d\U0001F469\u200d\U0001F467"))
+	require.Equal(t, ViolationMaxLength, MaxLengthIn(UTF16, 1)(ctx, "😀").Code)
+	require.Equal(t, ViolationMaxLength, MaxLengthIn(Bytes, 5)(ctx, "héllo").Code)
+	require.Equal(t, ViolationMaxLength, MaxRunes(5)(ctx, []byte("a")).Code)
+	require.Nil(t, MaxRunes(1)(ctx, ishelper.None[string]()))
+	require.Equal(t, ViolationMaxLength, MaxRunes(1)(ctx, ishelper.Some("ab")).Code)
+	require.Panics(t, func() { MaxLengthIn(LengthUnit(42), 1) })
+}
@@ -8,7 +8,7 @@ import (
 // Min returns a Rule that reports a violation when the numeric value is strictly
 // less than min.
 //
-// Accepted types: ishelper.Number (signed/unsigned integers, float32, float64).
+// Accepted types: all numeric types supported by ishelper.ToRat.
 // Unsupported/non-numeric values produce ViolationMin.
 //
 // Optional behavior: None -> nil (absent field skips the constraint);
@@ -19,7 +19,13 @@ func Min[T ishelper.Number](min T) Rule {
 		panic("is.Min: invalid min value")
 	}
 
-	return func(_ context.Context, value any) *Violation {
+	spec := RuleSpec{
+		Name:   "Min",
+		Code:   ViolationMin,
+		Params: map[string]any{"min": min},
+		Kinds:  []Kind{KindNumber},
+	}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
 		resolved, skip := ishelper.ExtractOptional(value)
 		if skip {
 			return nil
@@ -29,9 +35,9 @@ func Min[T ishelper.Number](min T) Rule {
 		if !ok || n.Cmp(limit) < 0 {
 			return &Violation{
 				Code:    ViolationMin,
-				Message: formatMessage(ViolationMin, map[string]any{"min": min}),
+				Message: FormatMessage(ViolationMin, map[string]any{"min": min}),
 			}
 		}
 		return nil
-	}
+	})
 }
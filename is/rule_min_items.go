@@ -0,0 +1,48 @@
+package is
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// MinItems returns a Rule that reports a violation when a collection has fewer
+// than min elements. Unlike MinLength, strings are not accepted.
+//
+// Accepted types: slice, array, map.
+// Unsupported types produce ViolationMinItems.
+// Violation params: "min".
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+func MinItems(min int) Rule {
+	spec := RuleSpec{
+		Name:   "MinItems",
+		Code:   ViolationMinItems,
+		Params: map[string]any{"min": min},
+		Kinds:  []Kind{KindList, KindMap},
+	}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
+		resolved, skip := ishelper.ExtractOptional(value)
+		if skip {
+			return nil
+		}
+		if n, ok := itemCount(resolved); ok && n >= min {
+			return nil
+		}
+		params := map[string]any{"min": min}
+		return &Violation{Code: ViolationMinItems, Message: FormatMessage(ViolationMinItems, params), Params: params}
+	})
+}
+
+// itemCount returns the number of elements of a slice, array or map.
+func itemCount(value any) (int, bool) {
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return rv.Len(), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,30 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMinItems(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rule := MinItems(2)
+	require.Nil(t, rule(ctx, []int{1, 2}))
+	require.Nil(t, rule(ctx, [3]string{}))
+	require.Nil(t, rule(ctx, map[string]int{"a": 1, "b": 2}))
+
+	got := rule(ctx, []int{1})
+	require.Equal(t, ViolationMinItems, got.Code)
+	require.Equal(t, "must contain at least 2 items", got.Message)
+	require.Equal(t, map[string]any{"min": 2}, got.Params)
+	got.Params["min"] = 0 // violations do not share their params
+	require.Equal(t, map[string]any{"min": 2}, rule(ctx, []int{1}).Params)
+	require.Equal(t, ViolationMinItems, rule(ctx, []int(nil)).Code)
+	require.Equal(t, ViolationMinItems, rule(ctx, "abc").Code)
+	require.Nil(t, rule(ctx, ishelper.None[[]int]()))
+	require.Nil(t, rule(ctx, ishelper.Some([]int{1, 2})))
+}
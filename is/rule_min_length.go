@@ -14,7 +14,13 @@ import (
 // Optional behavior: None -> nil (absent field skips the constraint);
 // Some(v) -> validates the unwrapped value.
 func MinLength(n int) Rule {
-	return func(_ context.Context, value any) *Violation {
+	spec := RuleSpec{
+		Name:   "MinLength",
+		Code:   ViolationMinLength,
+		Params: map[string]any{"min": n},
+		Kinds:  []Kind{KindString, KindList, KindMap},
+	}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
 		resolved, skip := ishelper.ExtractOptional(value)
 		if skip {
 			return nil
@@ -22,7 +28,7 @@ func MinLength(n int) Rule {
 		if resolved == nil {
 			return &Violation{
 				Code:    ViolationMinLength,
-				Message: formatMessage(ViolationMinLength, map[string]any{"min": n}),
+				Message: FormatMessage(ViolationMinLength, map[string]any{"min": n}),
 			}
 		}
 
@@ -32,15 +38,15 @@ func MinLength(n int) Rule {
 			if rv.Len() < n {
 				return &Violation{
 					Code:    ViolationMinLength,
-					Message: formatMessage(ViolationMinLength, map[string]any{"min": n}),
+					Message: FormatMessage(ViolationMinLength, map[string]any{"min": n}),
 				}
 			}
 			return nil
 		default:
 			return &Violation{
 				Code:    ViolationMinLength,
-				Message: formatMessage(ViolationMinLength, map[string]any{"min": n}),
+				Message: FormatMessage(ViolationMinLength, map[string]any{"min": n}),
 			}
 		}
-	}
+	})
 }
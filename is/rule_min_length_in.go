@@ -0,0 +1,49 @@
+package is
+
+import (
+	"context"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// MinLengthIn returns a Rule that reports a violation when the length of the
+// string value, measured in unit, is < n.
+//
+// Accepted type: string.
+// Unsupported types produce ViolationMinLength.
+//
+// Unknown units panic at rule construction time.
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+func MinLengthIn(unit LengthUnit, n int) Rule {
+	if !unit.valid() {
+		panic("is.MinLengthIn: invalid unit")
+	}
+
+	spec := RuleSpec{
+		Name:   "MinLengthIn",
+		Code:   ViolationMinLength,
+		Params: map[string]any{"unit": unit, "min": n},
+		Kinds:  []Kind{KindString},
+	}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
+		resolved, skip := ishelper.ExtractOptional(value)
+		if skip {
+			return nil
+		}
+
+		s, ok := resolved.(string)
+		if !ok || unit.count(s) < n {
+			return &Violation{
+				Code:    ViolationMinLength,
+				Message: FormatMessage(ViolationMinLength, map[string]any{"min": n}),
+			}
+		}
+		return nil
+	})
+}
+
+// MinRunes is MinLengthIn(Runes, n).
+func MinRunes(n int) Rule {
+	return MinLengthIn(Runes, n)
+}
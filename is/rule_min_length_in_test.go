@@ -0,0 +1,24 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMinLengthIn(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	require.Nil(t, MinRunes(2)(ctx, "é"+"é"))
+	require.Equal(t, ViolationMinLength, MinRunes(3)(ctx, "éé").Code)
+	require.Nil(t, MinLengthIn(Bytes, 4)(ctx, "éé"))
+	require.Equal(t, ViolationMinLength, MinLengthIn(Graphemes, 2)(ctx, "👍🏽").Code)
+	require.Nil(t, MinLengthIn(UTF16, 2)(ctx, "👍"))
+	require.Equal(t, ViolationMinLength, MinRunes(1)(ctx, 42).Code)
+	require.Nil(t, MinRunes(1)(ctx, ishelper.None[string]()))
+	require.Equal(t, ViolationMinLength, MinRunes(1)(ctx, ishelper.Some("")).Code)
+	require.Panics(t, func() { MinLengthIn(LengthUnit(-1), 1) })
+}
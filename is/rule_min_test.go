@@ -2,8 +2,11 @@ package is
 
 import (
 	"context"
+	"encoding/json"
 	"math"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/alexisvisco/valid/ishelper"
@@ -32,6 +35,27 @@ func TestMin(t *testing.T) {
 		{name: "nil value", min: 1, value: nil, wantError: true, wantCode: ViolationMin},
 		{name: "max uint64 equal", min: uint64(math.MaxUint64), value: uint64(math.MaxUint64), wantError: false},
 		{name: "max uint64 minus one", min: uint64(math.MaxUint64), value: uint64(math.MaxUint64 - 1), wantError: true, wantCode: ViolationMin},
+		// Arbitrary precision and named types
+		{name: "big.Int below", min: 1, value: big.NewInt(0), wantError: true, wantCode: ViolationMin},
+		{name: "big.Int above", min: 1, value: big.NewInt(2), wantError: false},
+		{name: "big.Rat below", min: 1, value: big.NewRat(99, 100), wantError: true, wantCode: ViolationMin},
+		{name: "big.Rat equal", min: 1, value: big.NewRat(100, 100), wantError: false},
+		{name: "big.Float above", min: 1, value: big.NewFloat(1.5), wantError: false},
+		{name: "big.Float infinite", min: 1, value: new(big.Float).SetInf(false), wantError: true, wantCode: ViolationMin},
+		{name: "nil big.Int", min: 1, value: (*big.Int)(nil), wantError: true, wantCode: ViolationMin},
+		{name: "json.Number below", min: 1, value: json.Number("0.99"), wantError: true, wantCode: ViolationMin},
+		{name: "json.Number equal", min: 1, value: json.Number("1.00"), wantError: false},
+		{name: "json.Number malformed", min: 1, value: json.Number("abc"), wantError: true, wantCode: ViolationMin},
+		{name: "json.Number exponent", min: 1, value: json.Number("1e3"), wantError: false},
+		{name: "json.Number exponent too long", min: 1, value: json.Number("1e1000000"), wantError: true, wantCode: ViolationMin},
+		{name: "json.Number fraction", min: 0, value: json.Number("1/3"), wantError: true, wantCode: ViolationMin},
+		{name: "json.Number base prefix", min: 1, value: json.Number("0x10"), wantError: true, wantCode: ViolationMin},
+		{name: "json.Number leading plus", min: 1, value: json.Number("+2"), wantError: true, wantCode: ViolationMin},
+		{name: "time.Duration below", min: int64(time.Second), value: time.Millisecond, wantError: true, wantCode: ViolationMin},
+		{name: "time.Duration above", min: int64(time.Second), value: time.Minute, wantError: false},
+		{name: "Rationer below", min: 1, value: testDecimal("0.10"), wantError: true, wantCode: ViolationMin},
+		{name: "Rationer equal", min: 1, value: testDecimal("1"), wantError: false},
+		{name: "nil Rationer pointer", min: 1, value: (*testDecimalPtr)(nil), wantError: true, wantCode: ViolationMin},
 		// Optional
 		{name: "None[int]", min: 1, value: ishelper.None[int](), wantError: false},
 		{name: "Some below", min: 1, value: ishelper.Some(0), wantError: true, wantCode: ViolationMin},
@@ -54,6 +78,8 @@ func TestMin(t *testing.T) {
 				got = Min(min)(context.Background(), tt.value)
 			case uint64:
 				got = Min(min)(context.Background(), tt.value)
+			case int64:
+				got = Min(min)(context.Background(), tt.value)
 			default:
 				require.Failf(t, "unsupported min type", "%T", tt.min)
 			}
@@ -65,3 +91,18 @@ func TestMin(t *testing.T) {
 		})
 	}
 }
+
+// testDecimal is a minimal decimal type implementing ishelper.Rationer.
+type testDecimal string
+
+func (d testDecimal) Rat() *big.Rat {
+	r, ok := new(big.Rat).SetString(string(d))
+	if !ok {
+		return nil
+	}
+	return r
+}
+
+type testDecimalPtr struct{ r *big.Rat }
+
+func (d *testDecimalPtr) Rat() *big.Rat { return d.r }
@@ -0,0 +1,35 @@
+package is
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+var objectIDRegex = regexp.MustCompile(`^[0-9a-fA-F]{24}$`)
+
+// MongoObjectID is a Rule that reports a violation when value is not the hex
+// form of a MongoDB ObjectID.
+//
+// Accepted type: string (24 hexadecimal characters).
+// Unsupported types and non-matching text produce ViolationObjectID.
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+var MongoObjectID Rule = WithSpec(RuleSpec{
+	Name:   "MongoObjectID",
+	Code:   ViolationObjectID,
+	Params: map[string]any{"pattern": objectIDRegex.String()},
+	Kinds:  []Kind{KindString},
+}, func(_ context.Context, value any) *Violation {
+	resolved, skip := ishelper.ExtractOptional(value)
+	if skip {
+		return nil
+	}
+	s, ok := resolved.(string)
+	if !ok || !objectIDRegex.MatchString(s) {
+		return &Violation{Code: ViolationObjectID, Message: FormatMessage(ViolationObjectID, nil)}
+	}
+	return nil
+})
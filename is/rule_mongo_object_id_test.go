@@ -0,0 +1,22 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMongoObjectID(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	require.Nil(t, MongoObjectID(ctx, "507f1f77bcf86cd799439011"))
+	require.Nil(t, MongoObjectID(ctx, "507F1F77BCF86CD799439011"))
+	require.Equal(t, ViolationObjectID, MongoObjectID(ctx, "507f1f77bcf86cd79943901").Code)
+	require.Equal(t, ViolationObjectID, MongoObjectID(ctx, "507f1f77bcf86cd79943901g").Code)
+	require.Equal(t, ViolationObjectID, MongoObjectID(ctx, 507).Code)
+	require.Nil(t, MongoObjectID(ctx, ishelper.None[string]()))
+	require.Nil(t, MongoObjectID(ctx, ishelper.Some("507f1f77bcf86cd799439011")))
+}
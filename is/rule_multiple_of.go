@@ -0,0 +1,47 @@
+package is
+
+import (
+	"context"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// MultipleOf returns a Rule that reports a violation when the numeric value is
+// not an integer multiple of step (e.g. MultipleOf(0.05) for cash rounding).
+//
+// Accepted types: all numeric types supported by ishelper.ToRat.
+// Floats, including step, are read through their shortest decimal
+// representation, so 0.15 is a multiple of 0.05.
+// Unsupported/non-numeric values produce ViolationMultipleOf.
+//
+// step must be > 0. Invalid steps panic at rule construction time.
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+func MultipleOf[T ishelper.Number](step T) Rule {
+	s, ok := ishelper.ToDecimalRat(step)
+	if !ok || s.Sign() <= 0 {
+		panic("is.MultipleOf: step must be > 0")
+	}
+
+	spec := RuleSpec{
+		Name:   "MultipleOf",
+		Code:   ViolationMultipleOf,
+		Params: map[string]any{"step": step},
+		Kinds:  []Kind{KindNumber},
+	}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
+		resolved, skip := ishelper.ExtractOptional(value)
+		if skip {
+			return nil
+		}
+
+		r, ok := ishelper.ToDecimalRat(resolved)
+		if !ok || !ishelper.IsMultipleOf(r, s) {
+			return &Violation{
+				Code:    ViolationMultipleOf,
+				Message: FormatMessage(ViolationMultipleOf, map[string]any{"step": step}),
+			}
+		}
+		return nil
+	})
+}
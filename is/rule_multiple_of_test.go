@@ -0,0 +1,34 @@
+package is
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultipleOf(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rule := MultipleOf(0.05)
+	require.Nil(t, rule(ctx, 0.15))
+	require.Nil(t, rule(ctx, 10))
+	require.Nil(t, rule(ctx, 0))
+	require.Nil(t, rule(ctx, -0.35))
+	require.Nil(t, rule(ctx, json.Number("1.95")))
+	require.Equal(t, ViolationMultipleOf, rule(ctx, 0.12).Code)
+	require.Equal(t, ViolationMultipleOf, rule(ctx, big.NewRat(1, 3)).Code)
+	require.Equal(t, ViolationMultipleOf, rule(ctx, "0.15").Code)
+	require.Nil(t, rule(ctx, ishelper.None[float64]()))
+	require.Equal(t, ViolationMultipleOf, rule(ctx, ishelper.Some(0.01)).Code)
+	require.Equal(t, "must be a multiple of 0.05", rule(ctx, 0.01).Message)
+
+	require.Nil(t, MultipleOf(5)(ctx, 25))
+	require.Equal(t, ViolationMultipleOf, MultipleOf(5)(ctx, 26).Code)
+	require.Panics(t, func() { MultipleOf(0) })
+	require.Panics(t, func() { MultipleOf(-1.5) })
+}
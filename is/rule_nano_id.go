@@ -0,0 +1,57 @@
+package is
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// NanoIDAlphabet is the default NanoID alphabet (URL-safe base64 characters).
+const NanoIDAlphabet = "useandom-26T198340PX75pxJACKVERYMINDBUSHWOLF_GQZbfghjklqvwyzrict"
+
+// NanoID returns a Rule that reports a violation when value is not a NanoID of
+// exactly length characters drawn from alphabet. An empty alphabet means
+// NanoIDAlphabet.
+//
+// Accepted type: string.
+// Unsupported types, wrong lengths and foreign characters produce ViolationNanoID.
+// Violation params: "length".
+//
+// length must be > 0. Invalid lengths panic at rule construction time.
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+func NanoID(length int, alphabet string) Rule {
+	if length <= 0 {
+		panic("is.NanoID: length must be > 0")
+	}
+	if alphabet == "" {
+		alphabet = NanoIDAlphabet
+	}
+	spec := RuleSpec{
+		Name:   "NanoID",
+		Code:   ViolationNanoID,
+		Params: map[string]any{"length": length, "alphabet": alphabet},
+		Kinds:  []Kind{KindString},
+	}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
+		resolved, skip := ishelper.ExtractOptional(value)
+		if skip {
+			return nil
+		}
+
+		s, ok := resolved.(string)
+		if ok && utf8.RuneCountInString(s) == length && !strings.ContainsFunc(s, func(r rune) bool {
+			return !strings.ContainsRune(alphabet, r)
+		}) {
+			return nil
+		}
+		params := map[string]any{"length": length}
+		return &Violation{
+			Code:    ViolationNanoID,
+			Message: FormatMessage(ViolationNanoID, params),
+			Params:  params,
+		}
+	})
+}
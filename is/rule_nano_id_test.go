@@ -0,0 +1,29 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNanoID(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rule := NanoID(21, "")
+	require.Nil(t, rule(ctx, "V1StGXR8_Z5jdHi6B-myT"))
+	require.Equal(t, ViolationNanoID, rule(ctx, "V1StGXR8_Z5jdHi6B-my").Code)
+	require.Equal(t, ViolationNanoID, rule(ctx, "V1StGXR8_Z5jdHi6B-my!").Code)
+	require.Equal(t, ViolationNanoID, rule(ctx, 21).Code)
+	rule(ctx, "x").Params["length"] = 0 // violations do not share their params
+	require.Equal(t, map[string]any{"length": 21}, rule(ctx, "x").Params)
+	require.Nil(t, rule(ctx, ishelper.None[string]()))
+	require.Nil(t, rule(ctx, ishelper.Some("V1StGXR8_Z5jdHi6B-myT")))
+
+	hex := NanoID(8, "0123456789abcdef")
+	require.Nil(t, hex(ctx, "deadbeef"))
+	require.Equal(t, ViolationNanoID, hex(ctx, "DEADBEEF").Code)
+	require.Panics(t, func() { NanoID(0, "") })
+}
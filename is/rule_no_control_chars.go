@@ -0,0 +1,17 @@
+package is
+
+import "unicode"
+
+// NoControlChars is a Rule that reports a violation when the string value contains
+// a control character (Unicode category Cc, including tabs and newlines).
+//
+// Accepted type: string. The empty string is accepted.
+// Unsupported types and strings with other characters produce ViolationControlChars.
+// Violation params: "char" (first offending character) and "position"
+// (its 0-based rune index).
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+var NoControlChars Rule = charsetRule("NoControlChars", ViolationControlChars, func(r rune) bool {
+	return !unicode.IsControl(r)
+})
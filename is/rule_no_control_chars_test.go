@@ -0,0 +1,24 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNoControlChars(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	require.Nil(t, NoControlChars(ctx, "Hello wörld !"))
+
+	got := NoControlChars(ctx, "line\nbreak")
+	require.Equal(t, ViolationControlChars, got.Code)
+	require.Equal(t, map[string]any{"char": "\n", "position": 4}, got.Params)
+	require.Equal(t, ViolationControlChars, NoControlChars(ctx, "\x00").Code)
+	require.Equal(t, ViolationControlChars, NoControlChars(ctx, ishelper.Some("a\u0085")).Code)
+	require.Equal(t, ViolationControlChars, NoControlChars(ctx, 42).Code)
+	require.Nil(t, NoControlChars(ctx, ishelper.None[string]()))
+}
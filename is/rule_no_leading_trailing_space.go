@@ -0,0 +1,52 @@
+package is
+
+import (
+	"context"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// NoLeadingTrailingSpace is a Rule that reports a violation when the string
+// value starts or ends with a Unicode white space character.
+//
+// Accepted type: string. The empty string is accepted.
+// Unsupported types and padded strings produce ViolationSurroundingSpace.
+// Violation params: "char" (offending space character) and "position"
+// (its 0-based rune index).
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+var NoLeadingTrailingSpace Rule = WithSpec(RuleSpec{
+	Name:  "NoLeadingTrailingSpace",
+	Code:  ViolationSurroundingSpace,
+	Kinds: []Kind{KindString},
+}, func(_ context.Context, value any) *Violation {
+	resolved, skip := ishelper.ExtractOptional(value)
+	if skip {
+		return nil
+	}
+
+	s, ok := resolved.(string)
+	if !ok {
+		return &Violation{Code: ViolationSurroundingSpace, Message: FormatMessage(ViolationSurroundingSpace, nil)}
+	}
+	if s == "" {
+		return nil
+	}
+
+	var params map[string]any
+	if first, _ := utf8.DecodeRuneInString(s); unicode.IsSpace(first) {
+		params = map[string]any{"char": string(first), "position": 0}
+	} else if last, _ := utf8.DecodeLastRuneInString(s); unicode.IsSpace(last) {
+		params = map[string]any{"char": string(last), "position": utf8.RuneCountInString(s) - 1}
+	} else {
+		return nil
+	}
+	return &Violation{
+		Code:    ViolationSurroundingSpace,
+		Message: FormatMessage(ViolationSurroundingSpace, params),
+		Params:  params,
+	}
+})
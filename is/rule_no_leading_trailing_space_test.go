@@ -0,0 +1,28 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNoLeadingTrailingSpace(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	require.Nil(t, NoLeadingTrailingSpace(ctx, "hello world"))
+	require.Nil(t, NoLeadingTrailingSpace(ctx, ""))
+
+	got := NoLeadingTrailingSpace(ctx, " hello")
+	require.Equal(t, ViolationSurroundingSpace, got.Code)
+	require.Equal(t, map[string]any{"char": " ", "position": 0}, got.Params)
+
+	got = NoLeadingTrailingSpace(ctx, "héllo ")
+	require.Equal(t, ViolationSurroundingSpace, got.Code)
+	require.Equal(t, map[string]any{"char": " ", "position": 5}, got.Params)
+	require.Equal(t, ViolationSurroundingSpace, NoLeadingTrailingSpace(ctx, ishelper.Some("\tx")).Code)
+	require.Equal(t, ViolationSurroundingSpace, NoLeadingTrailingSpace(ctx, 42).Code)
+	require.Nil(t, NoLeadingTrailingSpace(ctx, ishelper.None[string]()))
+}
@@ -13,7 +13,11 @@ import (
 //
 // Optional behavior: None -> nil (absent field skips the constraint);
 // Some(v) -> validates the unwrapped value.
-var NonNegative Rule = func(_ context.Context, value any) *Violation {
+var NonNegative Rule = WithSpec(RuleSpec{
+	Name:  "NonNegative",
+	Code:  ViolationNonNeg,
+	Kinds: []Kind{KindNumber},
+}, func(_ context.Context, value any) *Violation {
 	resolved, skip := ishelper.ExtractOptional(value)
 	if skip {
 		return nil
@@ -22,8 +26,8 @@ var NonNegative Rule = func(_ context.Context, value any) *Violation {
 	if !ok || n.Cmp(big.NewRat(0, 1)) < 0 {
 		return &Violation{
 			Code:    ViolationNonNeg,
-			Message: formatMessage(ViolationNonNeg, nil),
+			Message: FormatMessage(ViolationNonNeg, nil),
 		}
 	}
 	return nil
-}
+})
@@ -0,0 +1,30 @@
+package is
+
+import (
+	"context"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// Normalized returns a Rule that applies normalize to string values before
+// evaluating rules, e.g. strings.TrimSpace, strings.ToLower or ishelper.NFC.
+// The value itself is not modified. Rules are short-circuited: the first
+// violation is returned.
+//
+// Accepted types: any; non-string values are passed to rules unchanged.
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> normalizes and validates the unwrapped value.
+func Normalized(normalize func(string) string, rules ...Rule) Rule {
+	spec := RuleSpec{Name: "Normalized", Rules: DescribeRules(rules)}
+	return WithSpec(spec, func(ctx context.Context, value any) *Violation {
+		resolved, skip := ishelper.ExtractOptional(value)
+		if skip {
+			return nil
+		}
+		if s, ok := resolved.(string); ok {
+			resolved = normalize(s)
+		}
+		return applyRules(ctx, resolved, rules)
+	})
+}
@@ -0,0 +1,32 @@
+package is
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalized(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	trimLower := func(s string) string { return strings.ToLower(strings.TrimSpace(s)) }
+	rule := Normalized(trimLower, OneOf("card", "bank_transfer"))
+	require.Nil(t, rule(ctx, "  Card "))
+	require.Equal(t, ViolationOneOf, rule(ctx, "crypto").Code)
+
+	// "e" + combining acute accent is one rune once composed.
+	decomposed := "Rene\u0301"
+	require.Equal(t, ViolationMaxLength, MaxRunes(4)(ctx, decomposed).Code)
+	require.Nil(t, Normalized(ishelper.NFC, MaxRunes(4))(ctx, decomposed))
+	require.Nil(t, Normalized(ishelper.NFC, Equal("Ren\u00e9"))(ctx, decomposed))
+
+	require.Equal(t, ViolationRequired, Normalized(strings.TrimSpace, Required)(ctx, "   ").Code)
+	require.Nil(t, Normalized(strings.TrimSpace, Positive)(ctx, 3))
+	require.Nil(t, Normalized(strings.TrimSpace)(ctx, " x "))
+	require.Nil(t, rule(ctx, ishelper.None[string]()))
+	require.Nil(t, rule(ctx, ishelper.Some(" CARD")))
+}
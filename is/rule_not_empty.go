@@ -13,7 +13,11 @@ import (
 //
 // Optional behavior: None -> nil (absent field skips the constraint);
 // Some(v) -> validates the unwrapped value.
-func NotEmpty(_ context.Context, value any) *Violation {
+var NotEmpty Rule = WithSpec(RuleSpec{
+	Name:  "NotEmpty",
+	Code:  ViolationNotEmpty,
+	Kinds: []Kind{KindString, KindList, KindMap},
+}, func(_ context.Context, value any) *Violation {
 	resolved, skip := ishelper.ExtractOptional(value)
 	if skip {
 		return nil
@@ -21,7 +25,7 @@ func NotEmpty(_ context.Context, value any) *Violation {
 	if resolved == nil {
 		return &Violation{
 			Code:    ViolationNotEmpty,
-			Message: formatMessage(ViolationNotEmpty, nil),
+			Message: FormatMessage(ViolationNotEmpty, nil),
 		}
 	}
 
@@ -31,14 +35,14 @@ func NotEmpty(_ context.Context, value any) *Violation {
 		if rv.Len() == 0 {
 			return &Violation{
 				Code:    ViolationNotEmpty,
-				Message: formatMessage(ViolationNotEmpty, nil),
+				Message: FormatMessage(ViolationNotEmpty, nil),
 			}
 		}
 		return nil
 	default:
 		return &Violation{
 			Code:    ViolationNotEmpty,
-			Message: formatMessage(ViolationNotEmpty, nil),
+			Message: FormatMessage(ViolationNotEmpty, nil),
 		}
 	}
-}
+})
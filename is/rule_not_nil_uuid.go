@@ -0,0 +1,39 @@
+package is
+
+import (
+	"context"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+const nilUUID = "00000000-0000-0000-0000-000000000000"
+
+// NotNilUUID is a Rule that reports a violation when value is the nil UUID
+// (all zeros), which UUID accepts.
+//
+// Accepted type: string.
+// Unsupported types and malformed text produce ViolationUUID; the nil UUID
+// produces ViolationNilUUID.
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+var NotNilUUID Rule = WithSpec(RuleSpec{
+	Name:       "NotNilUUID",
+	Code:       ViolationUUID,
+	OtherCodes: []ViolationCode{ViolationNilUUID},
+	Params:     map[string]any{"pattern": uuidRegex.String()},
+	Kinds:      []Kind{KindString},
+}, func(_ context.Context, value any) *Violation {
+	resolved, skip := ishelper.ExtractOptional(value)
+	if skip {
+		return nil
+	}
+	s, ok := resolved.(string)
+	if !ok || !uuidRegex.MatchString(s) {
+		return &Violation{Code: ViolationUUID, Message: FormatMessage(ViolationUUID, nil)}
+	}
+	if s == nilUUID {
+		return &Violation{Code: ViolationNilUUID, Message: FormatMessage(ViolationNilUUID, nil)}
+	}
+	return nil
+})
@@ -0,0 +1,21 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotNilUUID(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	require.Nil(t, NotNilUUID(ctx, "123e4567-e89b-12d3-a456-426614174000"))
+	require.Equal(t, ViolationNilUUID, NotNilUUID(ctx, "00000000-0000-0000-0000-000000000000").Code)
+	require.Equal(t, ViolationUUID, NotNilUUID(ctx, "bad-uuid").Code)
+	require.Equal(t, ViolationUUID, NotNilUUID(ctx, 0).Code)
+	require.Nil(t, NotNilUUID(ctx, ishelper.None[string]()))
+	require.Equal(t, ViolationNilUUID, NotNilUUID(ctx, ishelper.Some("00000000-0000-0000-0000-000000000000")).Code)
+}
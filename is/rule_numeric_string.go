@@ -15,14 +15,19 @@ var numericRegex = regexp.MustCompile(`^[-+]?[0-9]+(?:\.[0-9]+)?$`)
 //
 // Optional behavior: None -> nil (absent field skips the constraint);
 // Some(v) -> validates the unwrapped value.
-var Numeric Rule = func(_ context.Context, value any) *Violation {
+var Numeric Rule = WithSpec(RuleSpec{
+	Name:   "Numeric",
+	Code:   ViolationNumeric,
+	Params: map[string]any{"pattern": numericRegex.String()},
+	Kinds:  []Kind{KindString},
+}, func(_ context.Context, value any) *Violation {
 	resolved, skip := ishelper.ExtractOptional(value)
 	if skip {
 		return nil
 	}
 	s, ok := resolved.(string)
 	if !ok || !numericRegex.MatchString(s) {
-		return &Violation{Code: ViolationNumeric, Message: formatMessage(ViolationNumeric, nil)}
+		return &Violation{Code: ViolationNumeric, Message: FormatMessage(ViolationNumeric, nil)}
 	}
 	return nil
-}
+})
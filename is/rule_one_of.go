@@ -15,7 +15,8 @@ import (
 // Optional behavior: None -> nil (absent field skips the constraint);
 // Some(v) -> validates the unwrapped value against the allowed list.
 func OneOf[T comparable](allowed ...T) Rule {
-	return func(_ context.Context, value any) *Violation {
+	spec := RuleSpec{Name: "OneOf", Code: ViolationOneOf, Params: map[string]any{"values": anySlice(allowed)}}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
 		resolved, skip := ishelper.ExtractOptional(value)
 		if skip {
 			return nil
@@ -36,7 +37,7 @@ func OneOf[T comparable](allowed ...T) Rule {
 		}
 		return &Violation{
 			Code:    ViolationOneOf,
-			Message: formatMessage(ViolationOneOf, map[string]any{"values": strings.Join(parts, ", ")}),
+			Message: FormatMessage(ViolationOneOf, map[string]any{"values": strings.Join(parts, ", ")}),
 		}
-	}
+	})
 }
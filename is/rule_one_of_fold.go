@@ -0,0 +1,44 @@
+package is
+
+import (
+	"context"
+	"strings"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// OneOfFold returns a Rule that reports a violation when value is not one of
+// allowed under Unicode case folding ("Card" matches "card").
+//
+// Accepted type: string.
+// Unsupported types and values outside the allowed set produce ViolationOneOf.
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value against the allowed list.
+func OneOfFold(allowed ...string) Rule {
+	values := strings.Join(allowed, ", ")
+	spec := RuleSpec{
+		Name:   "OneOfFold",
+		Code:   ViolationOneOf,
+		Params: map[string]any{"values": anySlice(allowed)},
+		Kinds:  []Kind{KindString},
+	}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
+		resolved, skip := ishelper.ExtractOptional(value)
+		if skip {
+			return nil
+		}
+
+		if s, ok := resolved.(string); ok {
+			for _, a := range allowed {
+				if strings.EqualFold(s, a) {
+					return nil
+				}
+			}
+		}
+		return &Violation{
+			Code:    ViolationOneOf,
+			Message: FormatMessage(ViolationOneOf, map[string]any{"values": values}),
+		}
+	})
+}
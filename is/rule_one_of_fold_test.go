@@ -0,0 +1,28 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOneOfFold(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rule := OneOfFold("card", "bank_transfer")
+	require.Nil(t, rule(ctx, "card"))
+	require.Nil(t, rule(ctx, "Card"))
+	require.Nil(t, rule(ctx, "BANK_TRANSFER"))
+
+	got := rule(ctx, "crypto")
+	require.Equal(t, ViolationOneOf, got.Code)
+	require.Equal(t, "must be one of card, bank_transfer", got.Message)
+	require.Equal(t, ViolationOneOf, rule(ctx, " card").Code)
+	require.Equal(t, ViolationOneOf, rule(ctx, 1).Code)
+	require.Nil(t, OneOfFold("straße")(ctx, "STRAßE"))
+	require.Nil(t, rule(ctx, ishelper.None[string]()))
+	require.Nil(t, rule(ctx, ishelper.Some("CARD")))
+}
@@ -0,0 +1,49 @@
+package is
+
+import (
+	"context"
+	"math/big"
+	"regexp"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// decimalRegex restricts ParsedNumber to plain decimal notation: big.Rat.SetString
+// alone would also accept fractions ("1/3") and base prefixes ("0x10"). The
+// exponent is capped to keep parsing cheap on untrusted input.
+var decimalRegex = regexp.MustCompile(`^[-+]?(?:[0-9]+(?:\.[0-9]*)?|\.[0-9]+)(?:[eE][-+]?[0-9]{1,4})?$`)
+
+// ParsedNumber returns a Rule that parses a decimal string exactly (via
+// big.Rat.SetString) and evaluates rules against the parsed *big.Rat, so
+// numeric rules such as Min, Between or MaxDecimals apply to query params and
+// CSV columns. Rules are short-circuited: the first violation is returned as is.
+//
+// Accepted type: string in decimal notation, e.g. "42", "-4.50", "1e3".
+// Unsupported types and unparsable text produce ViolationNumeric.
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+func ParsedNumber(rules ...Rule) Rule {
+	spec := RuleSpec{
+		Name:  "ParsedNumber",
+		Code:  ViolationNumeric,
+		Kinds: []Kind{KindString},
+		Rules: DescribeRules(rules),
+	}
+	return WithSpec(spec, func(ctx context.Context, value any) *Violation {
+		resolved, skip := ishelper.ExtractOptional(value)
+		if skip {
+			return nil
+		}
+
+		s, ok := resolved.(string)
+		if !ok || !decimalRegex.MatchString(s) {
+			return &Violation{Code: ViolationNumeric, Message: FormatMessage(ViolationNumeric, nil)}
+		}
+		r, ok := new(big.Rat).SetString(s)
+		if !ok {
+			return &Violation{Code: ViolationNumeric, Message: FormatMessage(ViolationNumeric, nil)}
+		}
+		return applyRules(ctx, r, rules)
+	})
+}
@@ -0,0 +1,31 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsedNumber(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rule := ParsedNumber(Min(1), MaxDecimals(2))
+	require.Nil(t, rule(ctx, "1"))
+	require.Nil(t, rule(ctx, "19.99"))
+	require.Nil(t, rule(ctx, "+2.5"))
+	require.Nil(t, rule(ctx, "1e3"))
+	require.Equal(t, ViolationMin, rule(ctx, "0.99").Code)
+	require.Equal(t, ViolationMaxDecimals, rule(ctx, "1.999").Code)
+	require.Equal(t, ViolationNumeric, rule(ctx, "abc").Code)
+	require.Equal(t, ViolationNumeric, rule(ctx, "1/2").Code)
+	require.Equal(t, ViolationNumeric, rule(ctx, "0x10").Code)
+	require.Equal(t, ViolationNumeric, rule(ctx, "").Code)
+	require.Equal(t, ViolationNumeric, rule(ctx, 5).Code)
+	require.Nil(t, rule(ctx, ishelper.None[string]()))
+	require.Equal(t, ViolationMin, rule(ctx, ishelper.Some("0")).Code)
+
+	require.Nil(t, ParsedNumber()(ctx, "-4.5"))
+}
@@ -0,0 +1,264 @@
+package is
+
+import (
+	"context"
+	"math"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// PasswordPolicy lists the requirements checked by Password. Zero values
+// disable the corresponding requirement.
+type PasswordPolicy struct {
+	// MinLength and MaxLength bound the length in runes.
+	MinLength, MaxLength int
+	// RequireLower, RequireUpper, RequireDigit and RequireSymbol require at
+	// least one character of the class. Symbols are any character that is
+	// neither a letter nor a digit.
+	RequireLower, RequireUpper, RequireDigit, RequireSymbol bool
+	// MaxRepeated is the maximum number of identical consecutive characters.
+	MaxRepeated int
+	// Banned rejects known passwords, e.g. CommonPasswords.
+	Banned BannedPasswords
+	// MinEntropy is the minimum PasswordEntropy, in bits.
+	MinEntropy float64
+	// NotContaining maps field names to values the password must not contain,
+	// case-insensitively (e.g. {"email": u.Email}). Values shorter than three
+	// runes are ignored.
+	NotContaining map[string]string
+}
+
+// BannedPasswords is implemented by password deny lists. Implementations may
+// query a remote service and should honor ctx.
+type BannedPasswords interface {
+	IsBanned(ctx context.Context, password string) bool
+}
+
+// PasswordSet is an in-memory BannedPasswords holding lowercased passwords.
+// Lookups are case-insensitive.
+type PasswordSet map[string]bool
+
+// IsBanned implements BannedPasswords.
+func (s PasswordSet) IsBanned(_ context.Context, password string) bool {
+	return s[strings.ToLower(password)]
+}
+
+// Password returns a Rule that reports a violation when value does not meet
+// policy. Each requirement has its own code; the first unmet one is reported,
+// in this order: length, character classes, repeated characters, field
+// values, banned list and entropy. Use PasswordPolicy.Violations to get every
+// unmet requirement separately.
+//
+// Accepted type: string.
+// Unsupported types produce ViolationPassword.
+// Violation params: "unmet" (the codes of every unmet requirement), plus
+// "min" for ViolationPasswordTooShort and ViolationPasswordEntropy, "max" for
+// ViolationPasswordTooLong and ViolationPasswordRepeated, "entropy" for
+// ViolationPasswordEntropy and "field" for ViolationPasswordContainsField.
+// Spec params: "min" and "max" (the length bounds), and "lower", "upper",
+// "digit" and "symbol" for the required classes; other requirements are not
+// described.
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+func Password(policy PasswordPolicy) Rule {
+	spec := RuleSpec{
+		Name: "Password",
+		Code: ViolationPassword,
+		OtherCodes: []ViolationCode{
+			ViolationPasswordTooShort, ViolationPasswordTooLong, ViolationPasswordLower, ViolationPasswordUpper,
+			ViolationPasswordDigit, ViolationPasswordSymbol, ViolationPasswordRepeated,
+			ViolationPasswordContainsField, ViolationPasswordBanned, ViolationPasswordEntropy,
+		},
+		Params: policy.params(),
+		Kinds:  []Kind{KindString},
+	}
+	return WithSpec(spec, func(ctx context.Context, value any) *Violation {
+		resolved, skip := ishelper.ExtractOptional(value)
+		if skip {
+			return nil
+		}
+		s, ok := resolved.(string)
+		if !ok {
+			return &Violation{Code: ViolationPassword, Message: FormatMessage(ViolationPassword, nil)}
+		}
+		violations := policy.Violations(ctx, s)
+		if len(violations) == 0 {
+			return nil
+		}
+		unmet := make([]string, len(violations))
+		for i, v := range violations {
+			unmet[i] = string(v.Code)
+		}
+		first := violations[0]
+		if first.Params == nil {
+			first.Params = map[string]any{}
+		}
+		first.Params["unmet"] = unmet
+		return first
+	})
+}
+
+// params returns the spec params of p: its length bounds and required
+// character classes. Banned lists and NotContaining values are left out, as
+// specs may be published (e.g. in generated schemas).
+func (p PasswordPolicy) params() map[string]any {
+	params := map[string]any{}
+	if p.MinLength > 0 {
+		params["min"] = p.MinLength
+	}
+	if p.MaxLength > 0 {
+		params["max"] = p.MaxLength
+	}
+	for class, required := range map[string]bool{"lower": p.RequireLower, "upper": p.RequireUpper, "digit": p.RequireDigit, "symbol": p.RequireSymbol} {
+		if required {
+			params[class] = true
+		}
+	}
+	if len(params) == 0 {
+		return nil
+	}
+	return params
+}
+
+// Violations returns one violation per requirement of p that password does
+// not meet, in the order documented on Password. Returns nil if all are met.
+func (p PasswordPolicy) Violations(ctx context.Context, password string) []*Violation {
+	var violations []*Violation
+	add := func(code ViolationCode, params map[string]any) {
+		violations = append(violations, &Violation{Code: code, Message: FormatMessage(code, params), Params: params})
+	}
+
+	length := utf8.RuneCountInString(password)
+	if p.MinLength > 0 && length < p.MinLength {
+		add(ViolationPasswordTooShort, map[string]any{"min": p.MinLength})
+	}
+	if p.MaxLength > 0 && length > p.MaxLength {
+		add(ViolationPasswordTooLong, map[string]any{"max": p.MaxLength})
+	}
+
+	var lower, upper, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		case !unicode.IsLetter(r):
+			symbol = true
+		}
+	}
+	if p.RequireLower && !lower {
+		add(ViolationPasswordLower, nil)
+	}
+	if p.RequireUpper && !upper {
+		add(ViolationPasswordUpper, nil)
+	}
+	if p.RequireDigit && !digit {
+		add(ViolationPasswordDigit, nil)
+	}
+	if p.RequireSymbol && !symbol {
+		add(ViolationPasswordSymbol, nil)
+	}
+
+	if p.MaxRepeated > 0 && maxRepeatedRunes(password) > p.MaxRepeated {
+		add(ViolationPasswordRepeated, map[string]any{"max": p.MaxRepeated})
+	}
+
+	folded := strings.ToLower(password)
+	fields := make([]string, 0, len(p.NotContaining))
+	for field := range p.NotContaining {
+		fields = append(fields, field)
+	}
+	slices.Sort(fields)
+	for _, field := range fields {
+		v := strings.ToLower(strings.TrimSpace(p.NotContaining[field]))
+		if utf8.RuneCountInString(v) >= 3 && strings.Contains(folded, v) {
+			add(ViolationPasswordContainsField, map[string]any{"field": field})
+			break
+		}
+	}
+
+	if p.Banned != nil && p.Banned.IsBanned(ctx, password) {
+		add(ViolationPasswordBanned, nil)
+	}
+
+	if p.MinEntropy > 0 {
+		if e := PasswordEntropy(password); e < p.MinEntropy {
+			add(ViolationPasswordEntropy, map[string]any{"min": p.MinEntropy, "entropy": math.Floor(e)})
+		}
+	}
+	return violations
+}
+
+// PasswordEntropy returns a rough estimate of the strength of password, in
+// bits: each character contributes log2 of the size of the character classes
+// used by the password, except characters repeating or continuing a sequence
+// from the previous one ("aaa", "abc", "321"), which contribute one bit.
+// It does not detect dictionary words; combine it with a banned list.
+func PasswordEntropy(password string) float64 {
+	pool := 0
+	seen := map[int]bool{}
+	for _, r := range password {
+		class, size := passwordCharClass(r)
+		if !seen[class] {
+			seen[class] = true
+			pool += size
+		}
+	}
+	if pool == 0 {
+		return 0
+	}
+
+	perChar := math.Log2(float64(pool))
+	bits := 0.0
+	prev := rune(-1)
+	for _, r := range password {
+		if d := r - prev; d >= -1 && d <= 1 {
+			bits++
+		} else {
+			bits += perChar
+		}
+		prev = r
+	}
+	return bits
+}
+
+// passwordCharClass returns an identifier and the size of the character
+// class of r for PasswordEntropy.
+func passwordCharClass(r rune) (int, int) {
+	switch {
+	case r >= 'a' && r <= 'z':
+		return 0, 26
+	case r >= 'A' && r <= 'Z':
+		return 1, 26
+	case r >= '0' && r <= '9':
+		return 2, 10
+	case r < utf8.RuneSelf:
+		return 3, 33
+	default:
+		return 4, 100
+	}
+}
+
+// maxRepeatedRunes returns the length of the longest run of identical runes.
+func maxRepeatedRunes(s string) int {
+	longest, run := 0, 0
+	prev := rune(-1)
+	for _, r := range s {
+		if r == prev {
+			run++
+		} else {
+			run = 1
+		}
+		longest = max(longest, run)
+		prev = r
+	}
+	return longest
+}
@@ -0,0 +1,90 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPassword(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rule := Password(PasswordPolicy{
+		MinLength:     10,
+		MaxLength:     64,
+		RequireLower:  true,
+		RequireUpper:  true,
+		RequireDigit:  true,
+		MaxRepeated:   3,
+		Banned:        CommonPasswords,
+		MinEntropy:    50,
+		NotContaining: map[string]string{"email": "jane.doe@example.com", "name": "Jo"},
+	})
+	require.Nil(t, rule(ctx, "Tr0ub4dor&3-horse"))
+	require.Nil(t, rule(ctx, "Jorge-Kx93-pqlw"))
+
+	got := rule(ctx, "short1A")
+	require.Equal(t, ViolationPasswordTooShort, got.Code)
+	require.Equal(t, "must be at least 10 characters long", got.Message)
+	require.Equal(t, map[string]any{
+		"min":   10,
+		"unmet": []string{string(ViolationPasswordTooShort), string(ViolationPasswordEntropy)},
+	}, got.Params)
+
+	require.Equal(t, ViolationPasswordUpper, rule(ctx, "no-uppercase-7x").Code)
+	require.Equal(t, ViolationPasswordRepeated, rule(ctx, "Kx9-aaaa-pqlw-Zt").Code)
+	got = rule(ctx, "Jane.Doe@Example.com1")
+	require.Equal(t, ViolationPasswordContainsField, got.Code)
+	require.Equal(t, "must not contain your email", got.Message)
+	require.Equal(t, ViolationPasswordBanned, Password(PasswordPolicy{Banned: CommonPasswords})(ctx, "Password123").Code)
+	require.Equal(t, ViolationPasswordEntropy, rule(ctx, "Abcdefghijk1").Code)
+
+	require.Equal(t, ViolationPassword, rule(ctx, 42).Code)
+	require.Nil(t, rule(ctx, ishelper.None[string]()))
+	require.Nil(t, rule(ctx, ishelper.Some("Tr0ub4dor&3-horse")))
+}
+
+func TestPasswordPolicyViolations(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	policy := PasswordPolicy{MinLength: 8, RequireUpper: true, RequireDigit: true, RequireSymbol: true}
+	var codes []ViolationCode
+	for _, v := range policy.Violations(ctx, "abc") {
+		codes = append(codes, v.Code)
+	}
+	require.Equal(t, []ViolationCode{
+		ViolationPasswordTooShort,
+		ViolationPasswordUpper,
+		ViolationPasswordDigit,
+		ViolationPasswordSymbol,
+	}, codes)
+	require.Nil(t, policy.Violations(ctx, "Abcdefg1!"))
+	require.Nil(t, PasswordPolicy{}.Violations(ctx, ""))
+}
+
+func TestPasswordSpec(t *testing.T) {
+	t.Parallel()
+
+	spec, ok := Describe(Password(PasswordPolicy{
+		MinLength: 12, RequireUpper: true, RequireSymbol: true, MaxRepeated: 3,
+		Banned: CommonPasswords, NotContaining: map[string]string{"email": "alice@example.com"},
+	}))
+	require.True(t, ok)
+	require.Equal(t, map[string]any{"min": 12, "upper": true, "symbol": true}, spec.Params)
+
+	spec, _ = Describe(Password(PasswordPolicy{}))
+	require.Nil(t, spec.Params)
+}
+
+func TestPasswordEntropy(t *testing.T) {
+	t.Parallel()
+
+	require.Zero(t, PasswordEntropy(""))
+	require.InDelta(t, 4.7+1+1, PasswordEntropy("abc"), 0.01)
+	require.InDelta(t, 4.7*3, PasswordEntropy("azm"), 0.01)
+	require.Less(t, PasswordEntropy("aaaaaaaaaaaa"), PasswordEntropy("Kx9-pqlw"))
+}
@@ -0,0 +1,219 @@
+package is
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// PhoneType is the kind of line a phone number belongs to, as far as the
+// numbering plan allows to tell.
+type PhoneType string
+
+const (
+	PhoneUnknown   PhoneType = ""
+	PhoneMobile    PhoneType = "mobile"
+	PhoneFixedLine PhoneType = "fixed_line"
+)
+
+// Phone is a phone number parsed by ParsePhone.
+type Phone struct {
+	// E164 is the normalized form, e.g. "+33612345678".
+	E164 string
+	// Region is the detected ISO 3166-1 alpha-2 region, e.g. "FR".
+	Region string
+	// CallingCode is the country calling code, without "+".
+	CallingCode string
+	// National is the national significant number, without trunk prefix.
+	National string
+	// Type is PhoneUnknown when the region plan has no MobilePrefixes.
+	Type PhoneType
+}
+
+// PhoneNumber returns a Rule that reports a violation when value is not a
+// phone number valid for the numbering plans in PhonePlans. Numbers in
+// international format ("+44 20 7946 0958", "0044...") are checked against the
+// plan of their calling code; numbers in national format ("020 7946 0958") are
+// read in defaultRegion. Spaces, dashes, dots, slashes and parentheses are
+// ignored.
+//
+// PhonePlans covers a few dozen regions. International numbers with another
+// assigned ITU calling code are accepted when their national number has at
+// least 4 digits and the whole number at most 15 (the E.164 limit).
+// PhoneNumber only validates: use ParsePhone, or PhoneParser with valid.Parse,
+// to get the normalized E.164 form.
+//
+// Accepted type: string.
+// Unsupported types, unknown calling codes and wrong lengths produce
+// ViolationPhone.
+// Violation params: "region" (the detected region, or defaultRegion).
+// An empty defaultRegion only accepts international format; a region missing
+// from PhonePlans panics at rule construction time.
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+func PhoneNumber(defaultRegion string) Rule {
+	defaultRegion = strings.ToUpper(defaultRegion)
+	if _, ok := PhonePlans[defaultRegion]; defaultRegion != "" && !ok {
+		panic("is.PhoneNumber: unknown region " + defaultRegion)
+	}
+	spec := RuleSpec{
+		Name:   "PhoneNumber",
+		Code:   ViolationPhone,
+		Params: map[string]any{"region": defaultRegion},
+		Kinds:  []Kind{KindString},
+	}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
+		resolved, skip := ishelper.ExtractOptional(value)
+		if skip {
+			return nil
+		}
+		s, ok := resolved.(string)
+		if !ok {
+			return &Violation{Code: ViolationPhone, Message: FormatMessage(ViolationPhone, nil)}
+		}
+		phone, ok := ParsePhone(s, defaultRegion)
+		if ok {
+			return nil
+		}
+		region := phone.Region
+		if region == "" {
+			region = defaultRegion
+		}
+		var params map[string]any
+		if region != "" {
+			params = map[string]any{"region": region}
+		}
+		return &Violation{Code: ViolationPhone, Message: FormatMessage(ViolationPhone, params), Params: params}
+	})
+}
+
+// ParsePhone parses raw like PhoneNumber and returns the normalized number.
+// When parsing fails, the returned Phone still carries the region if it could
+// be detected.
+func ParsePhone(raw, defaultRegion string) (Phone, bool) {
+	digits, international, ok := phoneDigits(raw)
+	if !ok {
+		return Phone{}, false
+	}
+
+	var callingCode, national string
+	if international {
+		for n := 1; n <= 3 && n < len(digits); n++ {
+			if _, ok := callingCodeRegions[digits[:n]]; ok || phoneRegions(digits[:n]) != nil {
+				callingCode, national = digits[:n], digits[n:]
+				break
+			}
+		}
+		if callingCode == "" {
+			return Phone{}, false
+		}
+	} else {
+		plan, ok := PhonePlans[strings.ToUpper(defaultRegion)]
+		if !ok {
+			return Phone{}, false
+		}
+		callingCode, national = plan.CallingCode, digits
+		if plan.TrunkPrefix != "" && len(national)-len(plan.TrunkPrefix) >= plan.MinLength {
+			national = strings.TrimPrefix(national, plan.TrunkPrefix)
+		}
+	}
+
+	region := phoneRegion(callingCode, national)
+	plan, ok := PhonePlans[region]
+	if !ok {
+		// Without a plan, only the E.164 limits apply.
+		region = callingCodeRegions[callingCode]
+		plan = PhonePlan{CallingCode: callingCode, MinLength: 4, MaxLength: 15 - len(callingCode)}
+	}
+	if international && plan.TrunkPrefix != "" && len(national) > plan.MaxLength {
+		// "+44 (0)20 ..." keeps the trunk prefix after the calling code.
+		national = strings.TrimPrefix(national, plan.TrunkPrefix)
+	}
+	phone := Phone{Region: region}
+	if len(national) < plan.MinLength || len(national) > plan.MaxLength || len(callingCode)+len(national) > 15 {
+		return phone, false
+	}
+
+	phone.E164 = "+" + callingCode + national
+	phone.CallingCode = callingCode
+	phone.National = national
+	if len(plan.MobilePrefixes) > 0 {
+		phone.Type = PhoneFixedLine
+		if hasAnyPrefix(national, plan.MobilePrefixes) {
+			phone.Type = PhoneMobile
+		}
+	}
+	return phone, true
+}
+
+// phoneDigits strips separators from raw and reports whether it is written in
+// international format ("+" or "00" prefix).
+func phoneDigits(raw string) (string, bool, bool) {
+	s := strings.TrimSpace(raw)
+	international := strings.HasPrefix(s, "+")
+	if international {
+		s = s[1:]
+	}
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case r == ' ' || r == '-' || r == '.' || r == '/' || r == '(' || r == ')':
+		default:
+			return "", false, false
+		}
+	}
+	digits := b.String()
+	if !international && strings.HasPrefix(digits, "00") {
+		international, digits = true, digits[2:]
+	}
+	if digits == "" || (international && digits[0] == '0') {
+		return "", false, false
+	}
+	return digits, international, true
+}
+
+// phoneRegions returns the sorted regions using callingCode.
+func phoneRegions(callingCode string) []string {
+	var regions []string
+	for region, plan := range PhonePlans {
+		if plan.CallingCode == callingCode {
+			regions = append(regions, region)
+		}
+	}
+	slices.Sort(regions)
+	return regions
+}
+
+// phoneRegion picks the region of a national number among the regions sharing
+// callingCode: the first whose LeadingDigits match, otherwise the one without
+// LeadingDigits.
+func phoneRegion(callingCode, national string) string {
+	fallback := ""
+	for _, region := range phoneRegions(callingCode) {
+		plan := PhonePlans[region]
+		if len(plan.LeadingDigits) == 0 {
+			if fallback == "" {
+				fallback = region
+			}
+			continue
+		}
+		if hasAnyPrefix(national, plan.LeadingDigits) {
+			return region
+		}
+	}
+	return fallback
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, p := range prefixes {
+		if strings.HasPrefix(s, p) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPhoneNumber(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fr := PhoneNumber("fr")
+	require.Nil(t, fr(ctx, "06 12 34 56 78"))
+	require.Nil(t, fr(ctx, "+33 6 12 34 56 78"))
+	require.Nil(t, fr(ctx, "0033612345678"))
+	require.Nil(t, fr(ctx, "+44 20 7946 0958"))
+	require.Nil(t, fr(ctx, "+1 (415) 555-0100"))
+
+	got := fr(ctx, "06 12 34 56")
+	require.Equal(t, ViolationPhone, got.Code)
+	require.Equal(t, map[string]any{"region": "FR"}, got.Params)
+	got = fr(ctx, "+44 20 7946")
+	require.Equal(t, map[string]any{"region": "GB"}, got.Params)
+	require.Equal(t, ViolationPhone, fr(ctx, "+999 123456789").Code)
+	require.Equal(t, ViolationPhone, fr(ctx, "06 12 34 56 7a").Code)
+	require.Equal(t, ViolationPhone, fr(ctx, "").Code)
+	require.Equal(t, ViolationPhone, fr(ctx, 612345678).Code)
+	require.Nil(t, fr(ctx, ishelper.None[string]()))
+	require.Nil(t, fr(ctx, ishelper.Some("0612345678")))
+
+	intl := PhoneNumber("")
+	require.Nil(t, intl(ctx, "+33612345678"))
+	got = intl(ctx, "0612345678")
+	require.Equal(t, ViolationPhone, got.Code)
+	require.Nil(t, got.Params)
+
+	require.Panics(t, func() { PhoneNumber("XX") })
+}
+
+func TestParsePhone(t *testing.T) {
+	t.Parallel()
+
+	phone, ok := ParsePhone("06 12 34 56 78", "FR")
+	require.True(t, ok)
+	require.Equal(t, Phone{E164: "+33612345678", Region: "FR", CallingCode: "33", National: "612345678", Type: PhoneMobile}, phone)
+
+	phone, ok = ParsePhone("+44 (0)20 7946 0958", "US")
+	require.True(t, ok)
+	require.Equal(t, "+442079460958", phone.E164)
+	require.Equal(t, "GB", phone.Region)
+	require.Equal(t, PhoneFixedLine, phone.Type)
+
+	phone, ok = ParsePhone("(416) 555-0100", "US")
+	require.True(t, ok)
+	require.Equal(t, "+14165550100", phone.E164)
+	require.Equal(t, "CA", phone.Region)
+	require.Equal(t, PhoneUnknown, phone.Type)
+
+	phone, ok = ParsePhone("1-415-555-0100", "US")
+	require.True(t, ok)
+	require.Equal(t, "+14155550100", phone.E164)
+	require.Equal(t, "US", phone.Region)
+
+	phone, ok = ParsePhone("+7 701 123 4567", "")
+	require.True(t, ok)
+	require.Equal(t, "KZ", phone.Region)
+	phone, ok = ParsePhone("8 912 345 67 89", "RU")
+	require.True(t, ok)
+	require.Equal(t, "+79123456789", phone.E164)
+	require.Equal(t, PhoneMobile, phone.Type)
+
+	phone, ok = ParsePhone("06 1234 5678", "IT")
+	require.True(t, ok)
+	require.Equal(t, "+390612345678", phone.E164)
+
+	phone, ok = ParsePhone("+36 1 234 5678", "")
+	require.True(t, ok)
+	require.Equal(t, Phone{E164: "+3612345678", Region: "HU", CallingCode: "36", National: "12345678"}, phone)
+	phone, ok = ParsePhone("+882 1234 5678", "")
+	require.True(t, ok)
+	require.Equal(t, "+88212345678", phone.E164)
+	require.Empty(t, phone.Region)
+	_, ok = ParsePhone("+36 123", "")
+	require.False(t, ok)
+	_, ok = ParsePhone("+36 1234 5678 9012 34", "")
+	require.False(t, ok)
+
+	_, ok = ParsePhone("0612345678", "XX")
+	require.False(t, ok)
+}
+
+func TestPhonePlans(t *testing.T) {
+	t.Parallel()
+
+	for region, plan := range PhonePlans {
+		require.Len(t, region, 2, region)
+		require.Contains(t, Countries, region, region)
+		require.Regexp(t, `^[1-9][0-9]{0,2}$`, plan.CallingCode, region)
+		require.LessOrEqual(t, plan.MinLength, plan.MaxLength, region)
+	}
+}
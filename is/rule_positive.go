@@ -13,7 +13,11 @@ import (
 //
 // Optional behavior: None -> nil (absent field skips the constraint);
 // Some(v) -> validates the unwrapped value.
-var Positive Rule = func(_ context.Context, value any) *Violation {
+var Positive Rule = WithSpec(RuleSpec{
+	Name:  "Positive",
+	Code:  ViolationPositive,
+	Kinds: []Kind{KindNumber},
+}, func(_ context.Context, value any) *Violation {
 	resolved, skip := ishelper.ExtractOptional(value)
 	if skip {
 		return nil
@@ -22,8 +26,8 @@ var Positive Rule = func(_ context.Context, value any) *Violation {
 	if !ok || n.Cmp(big.NewRat(0, 1)) <= 0 {
 		return &Violation{
 			Code:    ViolationPositive,
-			Message: formatMessage(ViolationPositive, nil),
+			Message: FormatMessage(ViolationPositive, nil),
 		}
 	}
 	return nil
-}
+})
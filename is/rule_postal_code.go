@@ -0,0 +1,80 @@
+package is
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// defaultPostalCodePattern is used for countries missing from PostalCodePatterns.
+const defaultPostalCodePattern = `^[A-Z0-9][A-Z0-9 -]{1,8}[A-Z0-9]$`
+
+// postalCodeRegexps caches compiled PostalCodePatterns entries by pattern.
+var postalCodeRegexps sync.Map
+
+// PostalCode returns a Rule that reports a violation when value is not a
+// postal code of country (an ISO 3166-1 alpha-2 code, case-insensitive), using
+// the pattern registered in PostalCodePatterns. The country is usually taken
+// from the payload itself, e.g. PostalCode(addr.Country).
+//
+// Countries without postal codes (empty pattern) accept any value. Countries
+// missing from PostalCodePatterns fall back to a permissive pattern of 3 to 10
+// letters, digits, spaces and hyphens. An invalid pattern in
+// PostalCodePatterns panics at rule construction time.
+//
+// Accepted type: string.
+// Unsupported types and non-matching text produce ViolationPostalCode.
+// Violation params: "country".
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+func PostalCode(country string) Rule {
+	country = strings.ToUpper(country)
+	pattern, known := PostalCodePatterns[country]
+	if !known {
+		pattern = defaultPostalCodePattern
+	}
+	var re *regexp.Regexp
+	if pattern != "" {
+		re = postalCodeRegexp(country, pattern)
+	}
+
+	spec := RuleSpec{
+		Name:   "PostalCode",
+		Code:   ViolationPostalCode,
+		Params: map[string]any{"country": country},
+		Kinds:  []Kind{KindString},
+	}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
+		resolved, skip := ishelper.ExtractOptional(value)
+		if skip {
+			return nil
+		}
+
+		s, ok := resolved.(string)
+		if ok && (re == nil || re.MatchString(s)) {
+			return nil
+		}
+		params := map[string]any{"country": country}
+		return &Violation{
+			Code:    ViolationPostalCode,
+			Message: FormatMessage(ViolationPostalCode, params),
+			Params:  params,
+		}
+	})
+}
+
+func postalCodeRegexp(country, pattern string) *regexp.Regexp {
+	if re, ok := postalCodeRegexps.Load(pattern); ok {
+		return re.(*regexp.Regexp)
+	}
+	re, err := regexp.Compile("(?i)" + pattern)
+	if err != nil {
+		panic("is.PostalCode: invalid pattern for " + country)
+	}
+	postalCodeRegexps.Store(pattern, re)
+	return re
+}
@@ -0,0 +1,68 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostalCode(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tests := []struct {
+		country string
+		valid   []string
+		invalid []string
+	}{
+		{country: "US", valid: []string{"94105", "94105-1234"}, invalid: []string{"9410", "94105-12"}},
+		{country: "fr", valid: []string{"75001"}, invalid: []string{"7500", "75 001"}},
+		{country: "GB", valid: []string{"SW1A 1AA", "sw1a1aa", "EC1A 1BB", "M1 1AE"}, invalid: []string{"SW1A", "12345"}},
+		{country: "CA", valid: []string{"K1A 0B1", "k1a0b1"}, invalid: []string{"D1A 0B1", "K1A-0B1"}},
+		{country: "NL", valid: []string{"1012 AB", "1012ab"}, invalid: []string{"1012"}},
+		{country: "JP", valid: []string{"100-0001", "1000001"}, invalid: []string{"100-001"}},
+		{country: "HK", valid: []string{"", "anything"}},
+		{country: "ZZ", valid: []string{"AB-123"}, invalid: []string{"A", "!!!"}},
+	}
+	for _, tt := range tests {
+		rule := PostalCode(tt.country)
+		for _, v := range tt.valid {
+			require.Nil(t, rule(ctx, v), "PostalCode(%q)(%q)", tt.country, v)
+		}
+		for _, v := range tt.invalid {
+			require.Equal(t, ViolationPostalCode, rule(ctx, v).Code, "PostalCode(%q)(%q)", tt.country, v)
+		}
+	}
+
+	fr := PostalCode("fr")
+	got := fr(ctx, 75001)
+	require.Equal(t, ViolationPostalCode, got.Code)
+	require.Equal(t, map[string]any{"country": "FR"}, got.Params)
+	got.Params["country"] = "" // violations do not share their params
+	require.Equal(t, map[string]any{"country": "FR"}, fr(ctx, 75001).Params)
+	require.Equal(t, "must be a valid postal code for FR", got.Message)
+	require.Nil(t, PostalCode("FR")(ctx, ishelper.None[string]()))
+	require.Nil(t, PostalCode("FR")(ctx, ishelper.Some("75001")))
+}
+
+func TestPostalCodeInvalidPattern(t *testing.T) {
+	// Not parallel: PostalCodePatterns is modified.
+	PostalCodePatterns["QQ"] = `^[0-9`
+	defer delete(PostalCodePatterns, "QQ")
+
+	require.PanicsWithValue(t, "is.PostalCode: invalid pattern for QQ", func() { PostalCode("qq") })
+}
+
+func TestPostalCodePatterns(t *testing.T) {
+	t.Parallel()
+
+	for country, pattern := range PostalCodePatterns {
+		_, known := Countries[country]
+		require.True(t, known, "unknown country %q", country)
+		if pattern != "" {
+			require.NotPanics(t, func() { postalCodeRegexp(country, pattern) }, "pattern for %q", country)
+		}
+	}
+}
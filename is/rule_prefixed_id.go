@@ -0,0 +1,49 @@
+package is
+
+import (
+	"context"
+	"strings"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// PrefixedID returns a Rule for typed-prefix identifiers such as Stripe's
+// "cus_..." IDs: the value must start with prefix, and the remainder is then
+// evaluated against rules, e.g. PrefixedID("cus_", NanoID(14, "")).
+// Rules are short-circuited: the first violation is returned as is.
+//
+// Accepted type: string.
+// Unsupported types and values without prefix produce ViolationHasPrefix.
+// An empty remainder produces ViolationRequired.
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+func PrefixedID(prefix string, rules ...Rule) Rule {
+	spec := RuleSpec{
+		Name:       "PrefixedID",
+		Code:       ViolationHasPrefix,
+		OtherCodes: []ViolationCode{ViolationRequired},
+		Params:     map[string]any{"prefix": prefix},
+		Kinds:      []Kind{KindString},
+		Rules:      DescribeRules(rules),
+	}
+	return WithSpec(spec, func(ctx context.Context, value any) *Violation {
+		resolved, skip := ishelper.ExtractOptional(value)
+		if skip {
+			return nil
+		}
+
+		s, ok := resolved.(string)
+		if !ok || !strings.HasPrefix(s, prefix) {
+			return &Violation{
+				Code:    ViolationHasPrefix,
+				Message: FormatMessage(ViolationHasPrefix, map[string]any{"prefix": prefix}),
+			}
+		}
+		rest := strings.TrimPrefix(s, prefix)
+		if rest == "" {
+			return &Violation{Code: ViolationRequired, Message: FormatMessage(ViolationRequired, nil)}
+		}
+		return applyRules(ctx, rest, rules)
+	})
+}
@@ -0,0 +1,26 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrefixedID(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rule := PrefixedID("cus_", NanoID(14, ""))
+	require.Nil(t, rule(ctx, "cus_NffrFeUfNV2Hib"))
+	require.Equal(t, ViolationHasPrefix, rule(ctx, "sub_NffrFeUfNV2Hib").Code)
+	require.Equal(t, ViolationNanoID, rule(ctx, "cus_short").Code)
+	require.Equal(t, ViolationRequired, rule(ctx, "cus_").Code)
+	require.Equal(t, ViolationHasPrefix, rule(ctx, 42).Code)
+	require.Nil(t, rule(ctx, ishelper.None[string]()))
+	require.Equal(t, ViolationHasPrefix, rule(ctx, ishelper.Some("x")).Code)
+
+	require.Nil(t, PrefixedID("user_", ULID)(ctx, "user_01ARZ3NDEKTSV4RRFFQ69G5FAV"))
+	require.Nil(t, PrefixedID("ord_")(ctx, "ord_anything"))
+}
@@ -0,0 +1,18 @@
+package is
+
+import "unicode"
+
+// Printable is a Rule that reports a violation when the string value contains
+// a non-printable character, as defined by unicode.IsPrint (the ASCII
+// space is the only space character accepted).
+//
+// Accepted type: string. The empty string is accepted.
+// Unsupported types and strings with other characters produce ViolationPrintable.
+// Violation params: "char" (first offending character) and "position"
+// (its 0-based rune index).
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+var Printable Rule = charsetRule("Printable", ViolationPrintable, func(r rune) bool {
+	return unicode.IsPrint(r)
+})
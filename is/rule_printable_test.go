@@ -0,0 +1,25 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrintable(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	require.Nil(t, Printable(ctx, "Hello, wörld! 👋"))
+
+	got := Printable(ctx, "tab\there")
+	require.Equal(t, ViolationPrintable, got.Code)
+	require.Equal(t, map[string]any{"char": "\t", "position": 3}, got.Params)
+	require.Equal(t, ViolationPrintable, Printable(ctx, "no\u00a0break").Code)
+	require.Equal(t, ViolationPrintable, Printable(ctx, "zero\u200bwidth").Code)
+	require.Equal(t, ViolationPrintable, Printable(ctx, ishelper.Some("\x00")).Code)
+	require.Equal(t, ViolationPrintable, Printable(ctx, 42).Code)
+	require.Nil(t, Printable(ctx, ishelper.None[string]()))
+}
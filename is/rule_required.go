@@ -14,12 +14,12 @@ import (
 //
 // For non-optional values, accepted types are any.
 // Nil, typed nil (pointer/slice/map/interface), and zero values produce ViolationRequired.
-func Required(_ context.Context, value any) *Violation {
+var Required Rule = WithSpec(RuleSpec{Name: "Required", Code: ViolationRequired}, func(_ context.Context, value any) *Violation {
 	if opt, ok := value.(ishelper.Optional); ok {
 		if opt.IsNone() {
 			return &Violation{
 				Code:    ViolationRequired,
-				Message: formatMessage(ViolationRequired, nil),
+				Message: FormatMessage(ViolationRequired, nil),
 			}
 		}
 		return nil
@@ -28,7 +28,7 @@ func Required(_ context.Context, value any) *Violation {
 	if value == nil {
 		return &Violation{
 			Code:    ViolationRequired,
-			Message: formatMessage(ViolationRequired, nil),
+			Message: FormatMessage(ViolationRequired, nil),
 		}
 	}
 
@@ -36,9 +36,9 @@ func Required(_ context.Context, value any) *Violation {
 	if ishelper.IsNil(rv) || rv.IsZero() {
 		return &Violation{
 			Code:    ViolationRequired,
-			Message: formatMessage(ViolationRequired, nil),
+			Message: FormatMessage(ViolationRequired, nil),
 		}
 	}
 
 	return nil
-}
+})
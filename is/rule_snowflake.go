@@ -0,0 +1,55 @@
+package is
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// Snowflake is a Rule that reports a violation when value is not a snowflake
+// ID (Twitter, Discord, ...): a non-zero unsigned 64-bit integer.
+//
+// Accepted types: string (base-10 digits without sign or leading zeros, as
+// snowflakes are usually transported in JSON) and integer types.
+// Unsupported types, zero, negative and out-of-range values produce ViolationSnowflake.
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+var Snowflake Rule = WithSpec(RuleSpec{
+	Name:  "Snowflake",
+	Code:  ViolationSnowflake,
+	Kinds: []Kind{KindString, KindNumber},
+}, func(_ context.Context, value any) *Violation {
+	resolved, skip := ishelper.ExtractOptional(value)
+	if skip {
+		return nil
+	}
+	if !isSnowflake(resolved) {
+		return &Violation{Code: ViolationSnowflake, Message: FormatMessage(ViolationSnowflake, nil)}
+	}
+	return nil
+})
+
+func isSnowflake(value any) bool {
+	if s, ok := value.(string); ok {
+		if s == "" || s[0] < '1' || s[0] > '9' {
+			return false
+		}
+		_, err := strconv.ParseUint(s, 10, 64)
+		return err == nil
+	}
+	if value == nil {
+		return false
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int() > 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return rv.Uint() > 0
+	default:
+		return false
+	}
+}
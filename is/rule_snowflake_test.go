@@ -0,0 +1,28 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnowflake(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	require.Nil(t, Snowflake(ctx, "175928847299117063"))
+	require.Nil(t, Snowflake(ctx, "18446744073709551615"))
+	require.Nil(t, Snowflake(ctx, int64(175928847299117063)))
+	require.Nil(t, Snowflake(ctx, uint64(1)))
+	require.Equal(t, ViolationSnowflake, Snowflake(ctx, "18446744073709551616").Code)
+	require.Equal(t, ViolationSnowflake, Snowflake(ctx, "0").Code)
+	require.Equal(t, ViolationSnowflake, Snowflake(ctx, "0175").Code)
+	require.Equal(t, ViolationSnowflake, Snowflake(ctx, "-175").Code)
+	require.Equal(t, ViolationSnowflake, Snowflake(ctx, "").Code)
+	require.Equal(t, ViolationSnowflake, Snowflake(ctx, -1).Code)
+	require.Equal(t, ViolationSnowflake, Snowflake(ctx, 1.5).Code)
+	require.Nil(t, Snowflake(ctx, ishelper.None[string]()))
+	require.Equal(t, ViolationSnowflake, Snowflake(ctx, ishelper.Some("abc")).Code)
+}
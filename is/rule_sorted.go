@@ -0,0 +1,71 @@
+package is
+
+import (
+	"cmp"
+	"context"
+	"reflect"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// Sorted is a Rule that reports a violation when the elements of a slice or
+// array are not in ascending order. Equal neighbours are allowed.
+//
+// Accepted types: slice, array of strings, integers or floats (including
+// named types based on them).
+// Unsupported types produce ViolationSorted.
+// Violation params: "index" (the first element smaller than its predecessor).
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+var Sorted Rule = WithSpec(RuleSpec{
+	Name:  "Sorted",
+	Code:  ViolationSorted,
+	Kinds: []Kind{KindList},
+}, func(_ context.Context, value any) *Violation {
+	resolved, skip := ishelper.ExtractOptional(value)
+	if skip {
+		return nil
+	}
+	rv, ok := sliceValue(resolved)
+	if !ok {
+		return &Violation{Code: ViolationSorted, Message: FormatMessage(ViolationSorted, nil)}
+	}
+	for i := 1; i < rv.Len(); i++ {
+		c, ok := compareOrdered(rv.Index(i-1), rv.Index(i))
+		if !ok {
+			return &Violation{Code: ViolationSorted, Message: FormatMessage(ViolationSorted, nil)}
+		}
+		if c > 0 {
+			return sortedViolation(i)
+		}
+	}
+	return nil
+})
+
+// compareOrdered compares two values of the same ordered kind.
+func compareOrdered(a, b reflect.Value) (int, bool) {
+	if a.Kind() == reflect.Interface {
+		a, b = a.Elem(), b.Elem()
+	}
+	if a.Kind() != b.Kind() {
+		return 0, false
+	}
+	switch a.Kind() {
+	case reflect.String:
+		return cmp.Compare(a.String(), b.String()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cmp.Compare(a.Int(), b.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return cmp.Compare(a.Uint(), b.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return cmp.Compare(a.Float(), b.Float()), true
+	default:
+		return 0, false
+	}
+}
+
+func sortedViolation(index int) *Violation {
+	params := map[string]any{"index": index}
+	return &Violation{Code: ViolationSorted, Message: FormatMessage(ViolationSorted, params), Params: params}
+}
@@ -0,0 +1,38 @@
+package is
+
+import (
+	"context"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// SortedBy returns a Rule that reports a violation when the elements of a
+// slice or array are not in ascending order according to compare, which
+// follows the cmp.Compare convention (negative when a < b).
+//
+// Accepted types: slice, array of T.
+// Unsupported types produce ViolationSorted.
+// Violation params: "index" (the first element ordered before its
+// predecessor).
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+func SortedBy[T any](compare func(a, b T) int) Rule {
+	spec := RuleSpec{Name: "SortedBy", Code: ViolationSorted, Kinds: []Kind{KindList}}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
+		resolved, skip := ishelper.ExtractOptional(value)
+		if skip {
+			return nil
+		}
+		items, ok := sliceItems[T](resolved)
+		if !ok {
+			return &Violation{Code: ViolationSorted, Message: FormatMessage(ViolationSorted, nil)}
+		}
+		for i := 1; i < len(items); i++ {
+			if compare(items[i-1], items[i]) > 0 {
+				return sortedViolation(i)
+			}
+		}
+		return nil
+	})
+}
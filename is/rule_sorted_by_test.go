@@ -0,0 +1,26 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortedBy(t *testing.T) {
+	t.Parallel()
+
+	type step struct{ Order int }
+	ctx := context.Background()
+	rule := SortedBy(func(a, b step) int { return a.Order - b.Order })
+	require.Nil(t, rule(ctx, []step{{1}, {2}, {2}}))
+	require.Nil(t, rule(ctx, []step{}))
+
+	got := rule(ctx, []step{{1}, {3}, {2}})
+	require.Equal(t, ViolationSorted, got.Code)
+	require.Equal(t, map[string]any{"index": 2}, got.Params)
+	require.Equal(t, ViolationSorted, rule(ctx, []int{1}).Code)
+	require.Nil(t, rule(ctx, ishelper.None[[]step]()))
+	require.Nil(t, rule(ctx, ishelper.Some([]step{{1}})))
+}
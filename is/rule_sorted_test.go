@@ -0,0 +1,30 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSorted(t *testing.T) {
+	t.Parallel()
+
+	type priority int
+	ctx := context.Background()
+	require.Nil(t, Sorted(ctx, []int{1, 2, 2, 3}))
+	require.Nil(t, Sorted(ctx, []string{"a", "b"}))
+	require.Nil(t, Sorted(ctx, []priority{1, 5}))
+	require.Nil(t, Sorted(ctx, []float64{}))
+	require.Nil(t, Sorted(ctx, []any{1, 2}))
+
+	got := Sorted(ctx, []int{1, 3, 2})
+	require.Equal(t, ViolationSorted, got.Code)
+	require.Equal(t, map[string]any{"index": 2}, got.Params)
+	require.Equal(t, ViolationSorted, Sorted(ctx, []any{1, "2"}).Code)
+	require.Equal(t, ViolationSorted, Sorted(ctx, []struct{}{{}, {}}).Code)
+	require.Equal(t, ViolationSorted, Sorted(ctx, 3).Code)
+	require.Nil(t, Sorted(ctx, ishelper.None[[]int]()))
+	require.Nil(t, Sorted(ctx, ishelper.Some([]int{1, 2})))
+}
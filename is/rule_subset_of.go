@@ -0,0 +1,46 @@
+package is
+
+import (
+	"context"
+	"slices"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// SubsetOf returns a Rule that reports a violation when a slice or array
+// contains an element that is not in allowed. An empty collection passes.
+//
+// Accepted types: slice, array of T.
+// Unsupported types produce ViolationSubsetOf.
+// Violation params: "values" (allowed, comma-separated) and, for a
+// disallowed element, "index" and "value".
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+func SubsetOf[T comparable](allowed ...T) Rule {
+	values := joinValues(allowed)
+	spec := RuleSpec{
+		Name:   "SubsetOf",
+		Code:   ViolationSubsetOf,
+		Params: map[string]any{"values": anySlice(allowed)},
+		Kinds:  []Kind{KindList},
+	}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
+		resolved, skip := ishelper.ExtractOptional(value)
+		if skip {
+			return nil
+		}
+		items, ok := sliceItems[T](resolved)
+		if !ok {
+			params := map[string]any{"values": values}
+			return &Violation{Code: ViolationSubsetOf, Message: FormatMessage(ViolationSubsetOf, params), Params: params}
+		}
+		for i, item := range items {
+			if !slices.Contains(allowed, item) {
+				params := map[string]any{"values": values, "index": i, "value": item}
+				return &Violation{Code: ViolationSubsetOf, Message: FormatMessage(ViolationSubsetOf, params), Params: params}
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,27 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubsetOf(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rule := SubsetOf("read", "write", "admin")
+	require.Nil(t, rule(ctx, []string{"read", "admin"}))
+	require.Nil(t, rule(ctx, []string{}))
+
+	got := rule(ctx, []string{"read", "delete"})
+	require.Equal(t, ViolationSubsetOf, got.Code)
+	require.Equal(t, "must only contain read, write, admin", got.Message)
+	require.Equal(t, map[string]any{"values": "read, write, admin", "index": 1, "value": "delete"}, got.Params)
+	require.Equal(t, ViolationSubsetOf, rule(ctx, "read").Code)
+	require.Equal(t, ViolationSubsetOf, rule(ctx, []int{1}).Code)
+	require.Nil(t, rule(ctx, ishelper.None[[]string]()))
+	require.Nil(t, rule(ctx, ishelper.Some([]string{"write"})))
+}
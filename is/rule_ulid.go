@@ -0,0 +1,36 @@
+package is
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// ulidRegex matches 26 Crockford base32 characters; the first one is at most
+// 7 so the value fits in 128 bits.
+var ulidRegex = regexp.MustCompile(`^[0-7][0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{25}$`)
+
+// ULID is a Rule that reports a violation when value is not a ULID.
+//
+// Accepted type: string (case-insensitive Crockford base32, 26 characters).
+// Unsupported types and non-matching text produce ViolationULID.
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+var ULID Rule = WithSpec(RuleSpec{
+	Name:   "ULID",
+	Code:   ViolationULID,
+	Params: map[string]any{"pattern": ulidRegex.String()},
+	Kinds:  []Kind{KindString},
+}, func(_ context.Context, value any) *Violation {
+	resolved, skip := ishelper.ExtractOptional(value)
+	if skip {
+		return nil
+	}
+	s, ok := resolved.(string)
+	if !ok || !ulidRegex.MatchString(s) {
+		return &Violation{Code: ViolationULID, Message: FormatMessage(ViolationULID, nil)}
+	}
+	return nil
+})
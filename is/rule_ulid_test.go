@@ -0,0 +1,24 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestULID(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	require.Nil(t, ULID(ctx, "01ARZ3NDEKTSV4RRFFQ69G5FAV"))
+	require.Nil(t, ULID(ctx, "01arz3ndektsv4rrffq69g5fav"))
+	require.Nil(t, ULID(ctx, "7ZZZZZZZZZZZZZZZZZZZZZZZZZ"))
+	require.Equal(t, ViolationULID, ULID(ctx, "8ZZZZZZZZZZZZZZZZZZZZZZZZZ").Code)
+	require.Equal(t, ViolationULID, ULID(ctx, "01ARZ3NDEKTSV4RRFFQ69G5FAI").Code)
+	require.Equal(t, ViolationULID, ULID(ctx, "01ARZ3NDEKTSV4RRFFQ69G5FA").Code)
+	require.Equal(t, ViolationULID, ULID(ctx, 1).Code)
+	require.Nil(t, ULID(ctx, ishelper.None[string]()))
+	require.Equal(t, ViolationULID, ULID(ctx, ishelper.Some("nope")).Code)
+}
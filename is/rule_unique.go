@@ -0,0 +1,58 @@
+package is
+
+import (
+	"context"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// Unique is a Rule that reports a violation when a slice or array contains the
+// same element more than once.
+//
+// Accepted types: slice, array (elements compared with ==).
+// Unsupported types and elements that are not comparable produce
+// ViolationUnique.
+// Violation params: "indices" (the indices of the elements equal to an
+// earlier one).
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+var Unique Rule = WithSpec(RuleSpec{
+	Name:  "Unique",
+	Code:  ViolationUnique,
+	Kinds: []Kind{KindList},
+}, func(_ context.Context, value any) *Violation {
+	resolved, skip := ishelper.ExtractOptional(value)
+	if skip {
+		return nil
+	}
+	rv, ok := sliceValue(resolved)
+	if !ok {
+		return &Violation{Code: ViolationUnique, Message: FormatMessage(ViolationUnique, nil)}
+	}
+
+	seen := make(map[any]bool, rv.Len())
+	var duplicates []int
+	for i := range rv.Len() {
+		item := rv.Index(i)
+		if !item.Comparable() {
+			return &Violation{Code: ViolationUnique, Message: FormatMessage(ViolationUnique, nil)}
+		}
+		key := item.Interface()
+		if seen[key] {
+			duplicates = append(duplicates, i)
+		}
+		seen[key] = true
+	}
+	return uniqueViolation(duplicates)
+})
+
+// uniqueViolation returns the ViolationUnique for duplicates, or nil if there
+// are none.
+func uniqueViolation(duplicates []int) *Violation {
+	if len(duplicates) == 0 {
+		return nil
+	}
+	params := map[string]any{"indices": duplicates}
+	return &Violation{Code: ViolationUnique, Message: FormatMessage(ViolationUnique, params), Params: params}
+}
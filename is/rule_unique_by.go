@@ -0,0 +1,42 @@
+package is
+
+import (
+	"context"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// UniqueBy returns a Rule that reports a violation when two elements of a
+// slice or array have the same key, e.g. two line items for the same SKU.
+//
+// Accepted types: slice, array of T.
+// Unsupported types produce ViolationUnique.
+// Violation params: "indices" (the indices of the elements whose key equals
+// the key of an earlier one).
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+func UniqueBy[T any, K comparable](key func(T) K) Rule {
+	spec := RuleSpec{Name: "UniqueBy", Code: ViolationUnique, Kinds: []Kind{KindList}}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
+		resolved, skip := ishelper.ExtractOptional(value)
+		if skip {
+			return nil
+		}
+		items, ok := sliceItems[T](resolved)
+		if !ok {
+			return &Violation{Code: ViolationUnique, Message: FormatMessage(ViolationUnique, nil)}
+		}
+
+		seen := make(map[K]bool, len(items))
+		var duplicates []int
+		for i, item := range items {
+			k := key(item)
+			if seen[k] {
+				duplicates = append(duplicates, i)
+			}
+			seen[k] = true
+		}
+		return uniqueViolation(duplicates)
+	})
+}
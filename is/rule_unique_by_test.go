@@ -0,0 +1,29 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUniqueBy(t *testing.T) {
+	t.Parallel()
+
+	type line struct {
+		SKU string
+		Qty int
+	}
+	ctx := context.Background()
+	rule := UniqueBy(func(l line) string { return l.SKU })
+	require.Nil(t, rule(ctx, []line{{"A", 1}, {"B", 1}}))
+	require.Nil(t, rule(ctx, []line(nil)))
+
+	got := rule(ctx, []line{{"A", 1}, {"B", 1}, {"A", 2}})
+	require.Equal(t, ViolationUnique, got.Code)
+	require.Equal(t, map[string]any{"indices": []int{2}}, got.Params)
+	require.Equal(t, ViolationUnique, rule(ctx, []string{"A"}).Code)
+	require.Nil(t, rule(ctx, ishelper.None[[]line]()))
+	require.Nil(t, rule(ctx, ishelper.Some([]line{{"A", 1}})))
+}
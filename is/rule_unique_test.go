@@ -0,0 +1,27 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnique(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	require.Nil(t, Unique(ctx, []string{"a", "b", "c"}))
+	require.Nil(t, Unique(ctx, []int{}))
+	require.Nil(t, Unique(ctx, [3]int{1, 2, 3}))
+
+	got := Unique(ctx, []string{"a", "b", "a", "c", "b"})
+	require.Equal(t, ViolationUnique, got.Code)
+	require.Equal(t, map[string]any{"indices": []int{2, 4}}, got.Params)
+	require.Equal(t, ViolationUnique, Unique(ctx, []any{1, "1", 1}).Code)
+	require.Equal(t, ViolationUnique, Unique(ctx, [][]int{{1}, {2}}).Code)
+	require.Equal(t, ViolationUnique, Unique(ctx, "aa").Code)
+	require.Nil(t, Unique(ctx, ishelper.None[[]string]()))
+	require.Nil(t, Unique(ctx, ishelper.Some([]string{"a"})))
+}
@@ -0,0 +1,18 @@
+package is
+
+import "unicode"
+
+// Uppercase is a Rule that reports a violation when the string value contains
+// a lowercase or titlecase letter. Characters without case (digits,
+// punctuation) are accepted.
+//
+// Accepted type: string. The empty string is accepted.
+// Unsupported types and strings with other characters produce ViolationUppercase.
+// Violation params: "char" (first offending character) and "position"
+// (its 0-based rune index).
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+var Uppercase Rule = charsetRule("Uppercase", ViolationUppercase, func(r rune) bool {
+	return !unicode.IsLower(r) && !unicode.IsTitle(r)
+})
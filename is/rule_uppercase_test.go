@@ -0,0 +1,24 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUppercase(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	require.Nil(t, Uppercase(ctx, "HELLO-WORLD_42"))
+	require.Nil(t, Uppercase(ctx, "ÉLAN"))
+
+	got := Uppercase(ctx, "HELlO")
+	require.Equal(t, ViolationUppercase, got.Code)
+	require.Equal(t, map[string]any{"char": "l", "position": 3}, got.Params)
+	require.Equal(t, ViolationUppercase, Uppercase(ctx, ishelper.Some("ß")).Code)
+	require.Equal(t, ViolationUppercase, Uppercase(ctx, 42).Code)
+	require.Nil(t, Uppercase(ctx, ishelper.None[string]()))
+}
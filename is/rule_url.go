@@ -13,18 +13,22 @@ import (
 //
 // Optional behavior: None -> nil (absent field skips the constraint);
 // Some(v) -> validates the unwrapped value.
-func URL(_ context.Context, value any) *Violation {
+var URL Rule = WithSpec(RuleSpec{
+	Name:  "URL",
+	Code:  ViolationURL,
+	Kinds: []Kind{KindString},
+}, func(_ context.Context, value any) *Violation {
 	resolved, skip := ishelper.ExtractOptional(value)
 	if skip {
 		return nil
 	}
 	s, ok := resolved.(string)
 	if !ok {
-		return &Violation{Code: ViolationURL, Message: formatMessage(ViolationURL, nil)}
+		return &Violation{Code: ViolationURL, Message: FormatMessage(ViolationURL, nil)}
 	}
 	u, err := url.ParseRequestURI(s)
 	if err != nil || u.Scheme == "" || u.Host == "" {
-		return &Violation{Code: ViolationURL, Message: formatMessage(ViolationURL, nil)}
+		return &Violation{Code: ViolationURL, Message: FormatMessage(ViolationURL, nil)}
 	}
 	return nil
-}
+})
@@ -15,14 +15,19 @@ var uuidRegex = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4
 //
 // Optional behavior: None -> nil (absent field skips the constraint);
 // Some(v) -> validates the unwrapped value.
-var UUID Rule = func(_ context.Context, value any) *Violation {
+var UUID Rule = WithSpec(RuleSpec{
+	Name:   "UUID",
+	Code:   ViolationUUID,
+	Params: map[string]any{"pattern": uuidRegex.String()},
+	Kinds:  []Kind{KindString},
+}, func(_ context.Context, value any) *Violation {
 	resolved, skip := ishelper.ExtractOptional(value)
 	if skip {
 		return nil
 	}
 	s, ok := resolved.(string)
 	if !ok || !uuidRegex.MatchString(s) {
-		return &Violation{Code: ViolationUUID, Message: formatMessage(ViolationUUID, nil)}
+		return &Violation{Code: ViolationUUID, Message: FormatMessage(ViolationUUID, nil)}
 	}
 	return nil
-}
+})
@@ -0,0 +1,75 @@
+package is
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// UUIDVersion returns a Rule that reports a violation when value is not an
+// RFC 9562 UUID of one of the given versions (e.g. UUIDVersion(4, 7)).
+//
+// Accepted type: string (case-insensitive, hyphenated form).
+// Unsupported types, malformed text, other versions and non-RFC variants
+// (including the nil and max UUIDs) produce ViolationUUIDVersion.
+// Violation params: "versions" (comma-separated allowed versions).
+//
+// Versions must be between 1 and 8, and at least one is required. Invalid
+// versions panic at rule construction time.
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+func UUIDVersion(versions ...int) Rule {
+	if len(versions) == 0 {
+		panic("is.UUIDVersion: at least one version is required")
+	}
+	parts := make([]string, len(versions))
+	for i, v := range versions {
+		if v < 1 || v > 8 {
+			panic("is.UUIDVersion: invalid version")
+		}
+		parts[i] = fmt.Sprintf("%d", v)
+	}
+	joined := strings.Join(parts, ", ")
+
+	spec := RuleSpec{
+		Name:   "UUIDVersion",
+		Code:   ViolationUUIDVersion,
+		Params: map[string]any{"versions": anySlice(versions)},
+		Kinds:  []Kind{KindString},
+	}
+	return WithSpec(spec, func(_ context.Context, value any) *Violation {
+		resolved, skip := ishelper.ExtractOptional(value)
+		if skip {
+			return nil
+		}
+
+		s, ok := resolved.(string)
+		if ok && uuidRegex.MatchString(s) && strings.ContainsRune("89abAB", rune(s[19])) {
+			if slices.Contains(versions, hexDigitValue(s[14])) {
+				return nil
+			}
+		}
+		params := map[string]any{"versions": joined}
+		return &Violation{
+			Code:    ViolationUUIDVersion,
+			Message: FormatMessage(ViolationUUIDVersion, params),
+			Params:  params,
+		}
+	})
+}
+
+// hexDigitValue returns the value of the hexadecimal digit c.
+// c must be a valid hexadecimal digit.
+func hexDigitValue(c byte) int {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c - '0')
+	case c >= 'a' && c <= 'f':
+		return int(c-'a') + 10
+	default:
+		return int(c-'A') + 10
+	}
+}
@@ -0,0 +1,30 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUUIDVersion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rule := UUIDVersion(4, 7)
+	require.Nil(t, rule(ctx, "f47ac10b-58cc-4372-a567-0e02b2c3d479"))
+	require.Nil(t, rule(ctx, "018F3A2B-7C4D-7E5F-8A9B-0C1D2E3F4A5B"))
+	require.Equal(t, ViolationUUIDVersion, rule(ctx, "123e4567-e89b-12d3-a456-426614174000").Code)
+	require.Equal(t, ViolationUUIDVersion, rule(ctx, "f47ac10b-58cc-4372-c567-0e02b2c3d479").Code)
+	require.Equal(t, ViolationUUIDVersion, rule(ctx, "00000000-0000-0000-0000-000000000000").Code)
+	require.Equal(t, ViolationUUIDVersion, rule(ctx, "bad-uuid").Code)
+	require.Equal(t, ViolationUUIDVersion, rule(ctx, 4).Code)
+	rule(ctx, "x").Params["versions"] = "" // violations do not share their params
+	require.Equal(t, map[string]any{"versions": "4, 7"}, rule(ctx, "x").Params)
+	require.Equal(t, "must be a UUID of version 4, 7", rule(ctx, "x").Message)
+	require.Nil(t, rule(ctx, ishelper.None[string]()))
+	require.Nil(t, rule(ctx, ishelper.Some("f47ac10b-58cc-4372-a567-0e02b2c3d479")))
+	require.Panics(t, func() { UUIDVersion() })
+	require.Panics(t, func() { UUIDVersion(9) })
+}
@@ -0,0 +1,38 @@
+package is
+
+import (
+	"context"
+	"unicode/utf8"
+
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// ValidUTF8 is a Rule that reports a violation when value is not valid UTF-8.
+//
+// Accepted types: string, []byte.
+// Unsupported types and invalid encodings produce ViolationUTF8.
+//
+// Optional behavior: None -> nil (absent field skips the constraint);
+// Some(v) -> validates the unwrapped value.
+var ValidUTF8 Rule = WithSpec(RuleSpec{
+	Name:  "ValidUTF8",
+	Code:  ViolationUTF8,
+	Kinds: []Kind{KindString, KindBytes},
+}, func(_ context.Context, value any) *Violation {
+	resolved, skip := ishelper.ExtractOptional(value)
+	if skip {
+		return nil
+	}
+
+	switch v := resolved.(type) {
+	case string:
+		if utf8.ValidString(v) {
+			return nil
+		}
+	case []byte:
+		if utf8.Valid(v) {
+			return nil
+		}
+	}
+	return &Violation{Code: ViolationUTF8, Message: FormatMessage(ViolationUTF8, nil)}
+})
@@ -0,0 +1,23 @@
+package is
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidUTF8(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	require.Nil(t, ValidUTF8(ctx, "héllo"))
+	require.Nil(t, ValidUTF8(ctx, ""))
+	require.Nil(t, ValidUTF8(ctx, []byte("😀")))
+	require.Equal(t, ViolationUTF8, ValidUTF8(ctx, "\xff\xfe").Code)
+	require.Equal(t, ViolationUTF8, ValidUTF8(ctx, []byte{0xc3}).Code)
+	require.Equal(t, ViolationUTF8, ValidUTF8(ctx, 42).Code)
+	require.Nil(t, ValidUTF8(ctx, ishelper.None[string]()))
+	require.Equal(t, ViolationUTF8, ValidUTF8(ctx, ishelper.Some("\xff")).Code)
+}
@@ -0,0 +1,118 @@
+package is
+
+import (
+	"context"
+	"maps"
+	"reflect"
+	"slices"
+)
+
+// RuleSpec describes a rule for tooling such as schema exporters, without
+// evaluating it. Rules carry a RuleSpec when built with WithSpec.
+type RuleSpec struct {
+	// Name is the name of the rule in package is ("Between"), or any name for
+	// custom rules.
+	Name string
+	// Code is the code of the violations reported by the rule.
+	Code ViolationCode
+	// OtherCodes lists the other codes the rule may report, if any (e.g.
+	// ViolationCardBrand for CreditCard).
+	OtherCodes []ViolationCode
+	// Params holds the arguments of the rule, named like the placeholders of
+	// its message where there is one (e.g. {"min": 1, "max": 10}). List
+	// arguments are []any.
+	Params map[string]any
+	// Kinds lists the kinds of values the rule accepts; other values are
+	// reported as violations. Nil means any value.
+	Kinds []Kind
+	// Rules describes the rules wrapped by the rule (e.g. by Normalized).
+	Rules []RuleSpec
+}
+
+// Kind is a category of values accepted by a rule, as reported by RuleSpec.
+type Kind string
+
+// Kinds of RuleSpec.
+const (
+	// KindString is string values.
+	KindString Kind = "string"
+	// KindBytes is []byte values.
+	KindBytes Kind = "bytes"
+	// KindNumber is the numeric types supported by ishelper.ToRat.
+	KindNumber Kind = "number"
+	// KindList is slices and arrays.
+	KindList Kind = "list"
+	// KindMap is maps.
+	KindMap Kind = "map"
+)
+
+// specProbe is passed as the value to rules built by WithSpec to read their
+// spec.
+type specProbe struct {
+	spec RuleSpec
+}
+
+// withSpecPC identifies the rules returned by WithSpec.
+var withSpecPC = reflect.ValueOf(WithSpec(RuleSpec{}, nil)).Pointer()
+
+// WithSpec returns a Rule that behaves like rule and is described by spec,
+// so that Describe can report it.
+//
+//go:noinline
+func WithSpec(spec RuleSpec, rule Rule) Rule {
+	return func(ctx context.Context, value any) *Violation {
+		if p, ok := value.(*specProbe); ok {
+			p.spec = spec
+			return nil
+		}
+		return rule(ctx, value)
+	}
+}
+
+// Describe returns the spec of rule. It returns false for rules not built
+// with WithSpec, which are never called. The spec is a copy: changing its
+// params or rules does not change the ones of rule.
+func Describe(rule Rule) (RuleSpec, bool) {
+	if rule == nil || reflect.ValueOf(rule).Pointer() != withSpecPC {
+		return RuleSpec{}, false
+	}
+	p := &specProbe{}
+	rule(context.Background(), p)
+	return p.spec.clone(), true
+}
+
+// clone returns a copy of s whose params, codes, kinds and rules are not
+// shared with s.
+func (s RuleSpec) clone() RuleSpec {
+	s.OtherCodes = slices.Clone(s.OtherCodes)
+	s.Params = maps.Clone(s.Params)
+	s.Kinds = slices.Clone(s.Kinds)
+	if s.Rules != nil {
+		rules := make([]RuleSpec, len(s.Rules))
+		for i, r := range s.Rules {
+			rules[i] = r.clone()
+		}
+		s.Rules = rules
+	}
+	return s
+}
+
+// DescribeRules returns the specs of rules, skipping rules without one.
+func DescribeRules(rules []Rule) []RuleSpec {
+	var specs []RuleSpec
+	for _, r := range rules {
+		if spec, ok := Describe(r); ok {
+			specs = append(specs, spec)
+		}
+	}
+	return specs
+}
+
+// anySlice converts values to []any for RuleSpec params.
+func anySlice[T any](values []T) []any {
+	out := make([]any, len(values))
+	for i, v := range values {
+		out[i] = v
+	}
+	return out
+}
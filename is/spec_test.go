@@ -0,0 +1,126 @@
+package is
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDescribe(t *testing.T) {
+	t.Parallel()
+
+	spec, ok := Describe(Between(1, 10))
+	require.True(t, ok)
+	require.Equal(t, RuleSpec{Name: "Between", Code: ViolationBetween, Params: map[string]any{"min": 1, "max": 10}, Kinds: []Kind{KindNumber}}, spec)
+
+	spec, ok = Describe(Email)
+	require.True(t, ok)
+	require.Equal(t, RuleSpec{Name: "Email", Code: ViolationEmail, Kinds: []Kind{KindString}}, spec)
+
+	spec, ok = Describe(E164)
+	require.True(t, ok)
+	require.Equal(t, map[string]any{"pattern": `^\+[1-9][0-9]{1,14}$`}, spec.Params)
+
+	spec, ok = Describe(OneOf("a", "b"))
+	require.True(t, ok)
+	require.Equal(t, []any{"a", "b"}, spec.Params["values"])
+
+	spec, ok = Describe(Normalized(strings.TrimSpace, Required, MinLength(2), func(context.Context, any) *Violation { return nil }))
+	require.True(t, ok)
+	require.Equal(t, RuleSpec{Name: "Normalized", Rules: []RuleSpec{
+		{Name: "Required", Code: ViolationRequired},
+		{Name: "MinLength", Code: ViolationMinLength, Params: map[string]any{"min": 2}, Kinds: []Kind{KindString, KindList, KindMap}},
+	}}, spec)
+
+	spec, ok = Describe(CreditCard(CardVisa))
+	require.True(t, ok)
+	require.Equal(t, []ViolationCode{ViolationCardBrand}, spec.OtherCodes)
+
+	spec, ok = Describe(IntParser.Rule(Positive))
+	require.True(t, ok)
+	require.Equal(t, "Parse", spec.Name)
+	require.Equal(t, []RuleSpec{{Name: "Positive", Code: ViolationPositive, Kinds: []Kind{KindNumber}}}, spec.Rules)
+
+	rule := Normalized(strings.TrimSpace, Between(1, 10))
+	spec, _ = Describe(rule)
+	spec.Rules[0].Params["min"] = 0 // specs do not share their params
+	spec, _ = Describe(rule)
+	require.Equal(t, map[string]any{"min": 1, "max": 10}, spec.Rules[0].Params)
+
+	require.Equal(t, []RuleSpec{{Name: "Email", Code: ViolationEmail, Kinds: []Kind{KindString}}},
+		DescribeRules([]Rule{Email, func(context.Context, any) *Violation { return nil }}))
+
+	called := false
+	_, ok = Describe(func(context.Context, any) *Violation { called = true; return nil })
+	require.False(t, ok)
+	require.False(t, called, "rules without spec must not be called")
+	_, ok = Describe(nil)
+	require.False(t, ok)
+}
+
+// Every built-in rule carries a spec with its name and code.
+func TestBuiltinSpecs(t *testing.T) {
+	t.Parallel()
+
+	builtins := map[string]Rule{
+		"Alpha": Alpha, "Alphanumeric": Alphanumeric, "ASCII": ASCII, "BCP47Tag": BCP47Tag, "BIC": BIC,
+		"E164": E164, "Email": Email, "Enum": Enum, "Finite": Finite, "Hex": Hex, "IBAN": IBAN,
+		"Integer": Integer, "ISO3166Alpha2": ISO3166Alpha2, "ISO3166Alpha3": ISO3166Alpha3,
+		"ISO4217Currency": ISO4217Currency, "ISO639Language": ISO639Language, "JSON": JSON,
+		"JSONObject": JSONObject, "KSUID": KSUID, "Latitude": Latitude, "Letters": Letters,
+		"LettersAndDigits": LettersAndDigits, "Longitude": Longitude, "Lowercase": Lowercase, "Luhn": Luhn,
+		"MongoObjectID": MongoObjectID, "NoControlChars": NoControlChars,
+		"NoLeadingTrailingSpace": NoLeadingTrailingSpace, "NonNegative": NonNegative, "NotEmpty": NotEmpty,
+		"NotNilUUID": NotNilUUID, "Numeric": Numeric, "Positive": Positive, "Printable": Printable,
+		"Required": Required, "Snowflake": Snowflake, "Sorted": Sorted, "ULID": ULID, "Unique": Unique,
+		"Uppercase": Uppercase, "URL": URL, "UUID": UUID, "ValidUTF8": ValidUTF8,
+
+		"Base32Of": Base32, "Base64Of": Base64URL, "Between": Between(1, 2), "CharsetOf": CharsetOf(unicode.Latin),
+		"Contains": Contains("a"), "ContainsAll": ContainsAll("a"), "ContainsNone": ContainsNone("a"),
+		"CreditCard": CreditCard(), "CurrencyAmount": CurrencyAmount("EUR"), "DecodedLength": DecodedLength(1, 2),
+		"Equal": Equal(1), "EqualFold": EqualFold("a"), "GreaterThan": GreaterThan(1),
+		"GreaterThanOrEqual": GreaterThanOrEqual(1), "HasPrefix": HasPrefix("a"), "HasPrefixFold": HasPrefixFold("a"),
+		"HasSuffix": HasSuffix("a"), "HexBytes": HexBytes(4), "IntStringBase": IntString(),
+		"JWTFormat": JWTFormat(), "Length": Length(1, 2), "LengthIn": LengthIn(Runes, 1, 2),
+		"LessThan": LessThan(1), "LessThanOrEqual": LessThanOrEqual(1), "Matches": Matches("a"), "Max": Max(1),
+		"MaxDecimals": MaxDecimals(2), "MaxDigits": MaxDigits(4, 2), "MaxItems": MaxItems(1),
+		"MaxLength": MaxLength(1), "MaxLengthIn": MaxRunes(1), "Min": Min(1), "MinItems": MinItems(1),
+		"MinLength": MinLength(1), "MinLengthIn": MinRunes(1), "MultipleOf": MultipleOf(2),
+		"NanoID": NanoID(21, ""), "OneOf": OneOf(1), "OneOfFold": OneOfFold("a"),
+		"ParsedNumber": ParsedNumber(), "Password": Password(PasswordPolicy{}), "PhoneNumber": PhoneNumber("FR"),
+		"PostalCode": PostalCode("FR"), "PrefixedID": PrefixedID("usr_"),
+		"SortedBy": SortedBy(func(a, b int) int { return a - b }), "SubsetOf": SubsetOf(1),
+		"UniqueBy": UniqueBy(func(s string) string { return s }), "UUIDVersion": UUIDVersion(4),
+	}
+	for name, rule := range builtins {
+		spec, ok := Describe(rule)
+		require.True(t, ok, name)
+		require.Equal(t, name, spec.Name)
+		require.NotEmpty(t, spec.Code, name)
+	}
+
+	spec, ok := Describe(Normalized(strings.TrimSpace))
+	require.True(t, ok)
+	require.Equal(t, "Normalized", spec.Name)
+}
+
+func TestWithSpec(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rule := WithSpec(RuleSpec{Name: "Even", Code: "EVEN"}, func(_ context.Context, value any) *Violation {
+		if n, ok := value.(int); ok && n%2 == 0 {
+			return nil
+		}
+		return &Violation{Code: "EVEN"}
+	})
+	require.Nil(t, rule(ctx, 2))
+	require.Equal(t, ViolationCode("EVEN"), rule(ctx, 3).Code)
+
+	spec, ok := Describe(rule)
+	require.True(t, ok)
+	require.Equal(t, "Even", spec.Name)
+}
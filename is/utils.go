@@ -1,11 +1,17 @@
 package is
 
 import (
+	"context"
 	"fmt"
+	"reflect"
 	"strings"
 )
 
-func formatMessage(code ViolationCode, params map[string]any) string {
+// FormatMessage returns the message of code in Messages, with each {name}
+// placeholder replaced by params[name] formatted with %v, as in the
+// violations of the built-in rules. Codes missing from Messages give
+// "invalid value".
+func FormatMessage(code ViolationCode, params map[string]any) string {
 	template, ok := Messages[code]
 	if !ok {
 		return "invalid value"
@@ -18,3 +24,54 @@ func formatMessage(code ViolationCode, params map[string]any) string {
 
 	return template
 }
+
+// applyRules evaluates rules against value and returns the first violation.
+func applyRules(ctx context.Context, value any, rules []Rule) *Violation {
+	for _, rule := range rules {
+		if v := rule(ctx, value); v != nil {
+			return v
+		}
+	}
+	return nil
+}
+
+// sliceValue returns the reflect.Value of value if it is a slice or an array.
+func sliceValue(value any) (reflect.Value, bool) {
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		return rv, true
+	default:
+		return reflect.Value{}, false
+	}
+}
+
+// sliceItems returns the elements of a slice or array whose elements are all
+// of type T (e.g. []T, [n]T or a named slice type).
+func sliceItems[T any](value any) ([]T, bool) {
+	if items, ok := value.([]T); ok {
+		return items, true
+	}
+	rv, ok := sliceValue(value)
+	if !ok {
+		return nil, false
+	}
+	items := make([]T, rv.Len())
+	for i := range items {
+		item, ok := rv.Index(i).Interface().(T)
+		if !ok {
+			return nil, false
+		}
+		items[i] = item
+	}
+	return items, true
+}
+
+// joinValues formats values as a comma-separated list for messages.
+func joinValues[T any](values []T) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = fmt.Sprintf("%v", v)
+	}
+	return strings.Join(parts, ", ")
+}
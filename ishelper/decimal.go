@@ -0,0 +1,85 @@
+package ishelper
+
+import (
+	"math/big"
+	"reflect"
+	"strconv"
+)
+
+var (
+	bigTwo  = big.NewInt(2)
+	bigFive = big.NewInt(5)
+)
+
+// ToDecimalRat converts a numeric value to *big.Rat like ToRat, except that
+// floating-point values are read through their shortest decimal representation
+// (strconv 'g' with precision -1). This makes 0.1 mean one tenth rather than
+// the nearest binary fraction, which is what decimal-oriented rules
+// (MaxDecimals, MultipleOf, MaxDigits) need.
+// Returns (nil, false) if the value is not a supported numeric type.
+func ToDecimalRat(value any) (*big.Rat, bool) {
+	if value == nil {
+		return nil, false
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Float32, reflect.Float64:
+		if _, ok := floatToRat(rv.Float()); !ok {
+			return nil, false
+		}
+		bits := 64
+		if rv.Kind() == reflect.Float32 {
+			bits = 32
+		}
+		r, ok := new(big.Rat).SetString(strconv.FormatFloat(rv.Float(), 'g', -1, bits))
+		return r, ok
+	default:
+		return ToRat(value)
+	}
+}
+
+// Decimals returns the number of digits after the decimal point needed to
+// write r exactly. Returns (0, false) if r has no finite decimal expansion
+// (e.g. 1/3).
+func Decimals(r *big.Rat) (int, bool) {
+	d := new(big.Int).Set(r.Denom())
+	twos := countFactor(d, bigTwo)
+	fives := countFactor(d, bigFive)
+	if d.Cmp(big.NewInt(1)) != 0 {
+		return 0, false
+	}
+	return max(twos, fives), true
+}
+
+// IntegerDigits returns the number of digits in the integer part of |r|.
+// Zero has no integer digits (0.5 is written with zero digits before the point
+// in SQL NUMERIC terms).
+func IntegerDigits(r *big.Rat) int {
+	q := new(big.Int).Quo(r.Num(), r.Denom())
+	q.Abs(q)
+	if q.Sign() == 0 {
+		return 0
+	}
+	return len(q.Text(10))
+}
+
+// IsMultipleOf reports whether r is an integer multiple of step.
+// step must be non-zero.
+func IsMultipleOf(r, step *big.Rat) bool {
+	return new(big.Rat).Quo(r, step).IsInt()
+}
+
+// countFactor divides n by f as many times as possible and returns the count.
+func countFactor(n, f *big.Int) int {
+	count := 0
+	q, m := new(big.Int), new(big.Int)
+	for n.Sign() != 0 {
+		q.QuoRem(n, f, m)
+		if m.Sign() != 0 {
+			break
+		}
+		n.Set(q)
+		count++
+	}
+	return count
+}
@@ -0,0 +1,160 @@
+package ishelper
+
+import (
+	"slices"
+	"unicode/utf8"
+)
+
+// Hangul syllable composition constants (Unicode 3.12).
+const (
+	hangulSBase  = 0xAC00
+	hangulLBase  = 0x1100
+	hangulVBase  = 0x1161
+	hangulTBase  = 0x11A7
+	hangulLCount = 19
+	hangulVCount = 21
+	hangulTCount = 28
+	hangulNCount = hangulVCount * hangulTCount
+	hangulSCount = hangulLCount * hangulNCount
+)
+
+var nfcCompositions = buildNFCCompositions()
+
+// combiningRange assigns a canonical combining class to the characters
+// lo..hi.
+type combiningRange struct {
+	lo, hi rune
+	class  uint8
+}
+
+// NFC returns s in Unicode Normalization Form C: canonically equivalent
+// sequences (e.g. "e" + U+0301 and "é") are mapped to the same composed form.
+// Invalid UTF-8 bytes are replaced with U+FFFD.
+func NFC(s string) string {
+	ascii := true
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			ascii = false
+			break
+		}
+	}
+	if ascii {
+		return s
+	}
+
+	runes := make([]rune, 0, len(s))
+	for _, r := range s {
+		runes = appendDecomposed(runes, r)
+	}
+	reorderCombining(runes)
+	return string(compose(runes))
+}
+
+// appendDecomposed appends the full canonical decomposition of r to dst.
+func appendDecomposed(dst []rune, r rune) []rune {
+	if r >= hangulSBase && r < hangulSBase+hangulSCount {
+		i := r - hangulSBase
+		dst = append(dst, hangulLBase+i/hangulNCount, hangulVBase+(i%hangulNCount)/hangulTCount)
+		if t := i % hangulTCount; t != 0 {
+			dst = append(dst, hangulTBase+t)
+		}
+		return dst
+	}
+	d, ok := nfcDecompositions[r]
+	if !ok {
+		return append(dst, r)
+	}
+	for _, c := range d {
+		dst = appendDecomposed(dst, c)
+	}
+	return dst
+}
+
+// reorderCombining sorts each run of combining marks by combining class,
+// keeping marks of the same class in order.
+func reorderCombining(runes []rune) {
+	for start := 0; start < len(runes); {
+		if combiningClass(runes[start]) == 0 {
+			start++
+			continue
+		}
+		end := start
+		for end < len(runes) && combiningClass(runes[end]) != 0 {
+			end++
+		}
+		slices.SortStableFunc(runes[start:end], func(a, b rune) int {
+			return int(combiningClass(a)) - int(combiningClass(b))
+		})
+		start = end
+	}
+}
+
+// compose applies the canonical composition algorithm in place.
+func compose(runes []rune) []rune {
+	if len(runes) == 0 {
+		return runes
+	}
+	out := runes[:1]
+	starter := 0
+	lastClass := int(combiningClass(runes[0]))
+	if lastClass != 0 {
+		lastClass = 256 // no starter yet: block composition
+	}
+	for _, c := range runes[1:] {
+		class := int(combiningClass(c))
+		if composite, ok := composePair(out[starter], c); ok && (lastClass < class || lastClass == 0) {
+			out[starter] = composite
+			continue
+		}
+		if class == 0 {
+			starter = len(out)
+		}
+		lastClass = class
+		out = append(out, c)
+	}
+	return out
+}
+
+func composePair(a, b rune) (rune, bool) {
+	if a >= hangulLBase && a < hangulLBase+hangulLCount && b >= hangulVBase && b < hangulVBase+hangulVCount {
+		return hangulSBase + ((a-hangulLBase)*hangulVCount+b-hangulVBase)*hangulTCount, true
+	}
+	if a >= hangulSBase && a < hangulSBase+hangulSCount && (a-hangulSBase)%hangulTCount == 0 &&
+		b > hangulTBase && b < hangulTBase+hangulTCount {
+		return a + b - hangulTBase, true
+	}
+	c, ok := nfcCompositions[[2]rune{a, b}]
+	return c, ok
+}
+
+func combiningClass(r rune) uint8 {
+	i, found := slices.BinarySearchFunc(nfcCombiningClasses, r, func(e combiningRange, r rune) int {
+		switch {
+		case r < e.lo:
+			return 1
+		case r > e.hi:
+			return -1
+		default:
+			return 0
+		}
+	})
+	if !found {
+		return 0
+	}
+	return nfcCombiningClasses[i].class
+}
+
+func buildNFCCompositions() map[[2]rune]rune {
+	excluded := make(map[rune]bool, len(nfcCompositionExclusions))
+	for _, r := range nfcCompositionExclusions {
+		excluded[r] = true
+	}
+	m := make(map[[2]rune]rune, len(nfcDecompositions))
+	for composite, d := range nfcDecompositions {
+		pair := []rune(d)
+		if len(pair) == 2 && !excluded[composite] {
+			m[[2]rune{pair[0], pair[1]}] = composite
+		}
+	}
+	return m
+}
@@ -1,10 +1,19 @@
 package ishelper
 
 import (
+	"encoding/json"
 	"math"
 	"math/big"
+	"reflect"
+	"regexp"
 )
 
+// jsonNumberRegex is the JSON number grammar: big.Rat.SetString alone would
+// also accept fractions ("1/3") and base prefixes ("0x10"). The exponent is
+// capped to 4 digits, like is.ParsedNumber, to keep parsing cheap on
+// untrusted input.
+var jsonNumberRegex = regexp.MustCompile(`^-?(?:0|[1-9][0-9]*)(?:\.[0-9]+)?(?:[eE][-+]?[0-9]{1,4})?$`)
+
 type signed interface {
 	~int | ~int8 | ~int16 | ~int32 | ~int64
 }
@@ -22,8 +31,21 @@ type Number interface {
 	signed | unsigned | floating
 }
 
+// Rationer is implemented by arbitrary-precision numeric types (e.g. decimal
+// types used for money) that can expose their exact value as a *big.Rat.
+// A nil result is treated as a non-numeric value.
+type Rationer interface {
+	Rat() *big.Rat
+}
+
 // ToRat converts any numeric value to *big.Rat for exact comparison.
+//
+// Supported values: Go primitive numbers and named types based on them
+// (e.g. time.Duration), *big.Int, *big.Rat, *big.Float, json.Number (in JSON
+// number syntax, with an exponent of at most 4 digits) and any type
+// implementing Rationer. NaN and infinite values are not supported.
 // Returns (nil, false) if the value is not a supported numeric type.
+// The returned *big.Rat is never shared with the input value.
 func ToRat(value any) (*big.Rat, bool) {
 	switch v := value.(type) {
 	case int:
@@ -49,20 +71,72 @@ func ToRat(value any) (*big.Rat, bool) {
 	case uintptr:
 		return new(big.Rat).SetUint64(uint64(v)), true
 	case float32:
-		f := float64(v)
-		if math.IsNaN(f) || math.IsInf(f, 0) {
+		return floatToRat(float64(v))
+	case float64:
+		return floatToRat(v)
+	case *big.Int:
+		if v == nil {
 			return nil, false
 		}
-		r := new(big.Rat)
-		r.SetFloat64(f)
+		return new(big.Rat).SetInt(v), true
+	case *big.Rat:
+		if v == nil {
+			return nil, false
+		}
+		return new(big.Rat).Set(v), true
+	case *big.Float:
+		if v == nil || v.IsInf() {
+			return nil, false
+		}
+		r, _ := v.Rat(nil)
 		return r, true
-	case float64:
-		if math.IsNaN(v) || math.IsInf(v, 0) {
+	case json.Number:
+		if !jsonNumberRegex.MatchString(string(v)) {
+			return nil, false
+		}
+		r, ok := new(big.Rat).SetString(string(v))
+		if !ok {
 			return nil, false
 		}
-		r := new(big.Rat)
-		r.SetFloat64(v)
 		return r, true
+	case Rationer:
+		if rv := reflect.ValueOf(v); IsNil(rv) {
+			return nil, false
+		}
+		r := v.Rat()
+		if r == nil {
+			return nil, false
+		}
+		return new(big.Rat).Set(r), true
+	default:
+		return reflectToRat(value)
+	}
+}
+
+// floatToRat converts f to *big.Rat, rejecting NaN and infinities.
+func floatToRat(f float64) (*big.Rat, bool) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, false
+	}
+	r := new(big.Rat)
+	r.SetFloat64(f)
+	return r, true
+}
+
+// reflectToRat handles named types whose underlying kind is numeric
+// (e.g. time.Duration or type Cents int64).
+func reflectToRat(value any) (*big.Rat, bool) {
+	if value == nil {
+		return nil, false
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return big.NewRat(rv.Int(), 1), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Rat).SetUint64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return floatToRat(rv.Float())
 	default:
 		return nil, false
 	}
@@ -0,0 +1,244 @@
+package ishelper
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// UTF16Len returns the number of UTF-16 code units needed to encode s, which
+// is what JavaScript's String.prototype.length reports. Invalid UTF-8 bytes
+// count as one unit each (they decode to U+FFFD).
+func UTF16Len(s string) int {
+	n := 0
+	for _, r := range s {
+		if r >= 0x10000 {
+			n += 2
+		} else {
+			n++
+		}
+	}
+	return n
+}
+
+// GraphemeCount returns the number of user-perceived characters in s.
+//
+// It implements the extended grapheme cluster boundaries of Unicode UAX #29
+// for the cases that matter in practice: CR LF, combining and spacing marks,
+// Hangul syllable sequences, regional-indicator flag pairs, emoji modifiers,
+// variation selectors and ZWJ emoji sequences. Prepend characters are not
+// special-cased.
+func GraphemeCount(s string) int {
+	count := 0
+	prev := gbOther
+	riRun := 0        // consecutive regional indicators ending at the previous rune
+	pictZWJ := false  // previous rune is ZWJ preceded by Extended_Pictographic Extend*
+	pictSeen := false // current cluster has Extended_Pictographic followed only by Extend
+	for i, r := range s {
+		cur := graphemeBreakClass(r)
+		if i == 0 || isGraphemeBreak(prev, cur, riRun, pictZWJ) {
+			count++
+			pictSeen = false
+		}
+
+		switch cur {
+		case gbRegionalIndicator:
+			riRun++
+		default:
+			riRun = 0
+		}
+		pictZWJ = cur == gbZWJ && pictSeen
+		switch {
+		case cur == gbPictographic:
+			pictSeen = true
+		case cur != gbExtend:
+			pictSeen = false
+		}
+		prev = cur
+	}
+	return count
+}
+
+type graphemeClass int
+
+const (
+	gbOther graphemeClass = iota
+	gbCR
+	gbLF
+	gbControl
+	gbExtend
+	gbZWJ
+	gbSpacingMark
+	gbRegionalIndicator
+	gbPictographic
+	gbL
+	gbV
+	gbT
+	gbLV
+	gbLVT
+)
+
+func isGraphemeBreak(prev, cur graphemeClass, riRun int, pictZWJ bool) bool {
+	switch {
+	case prev == gbCR && cur == gbLF: // GB3
+		return false
+	case prev == gbCR || prev == gbLF || prev == gbControl: // GB4
+		return true
+	case cur == gbCR || cur == gbLF || cur == gbControl: // GB5
+		return true
+	case prev == gbL && (cur == gbL || cur == gbV || cur == gbLV || cur == gbLVT): // GB6
+		return false
+	case (prev == gbLV || prev == gbV) && (cur == gbV || cur == gbT): // GB7
+		return false
+	case (prev == gbLVT || prev == gbT) && cur == gbT: // GB8
+		return false
+	case cur == gbExtend || cur == gbZWJ || cur == gbSpacingMark: // GB9, GB9a
+		return false
+	case pictZWJ && cur == gbPictographic: // GB11
+		return false
+	case prev == gbRegionalIndicator && cur == gbRegionalIndicator: // GB12, GB13
+		return riRun%2 == 0
+	default: // GB999
+		return true
+	}
+}
+
+func graphemeBreakClass(r rune) graphemeClass {
+	switch {
+	case r == '\r':
+		return gbCR
+	case r == '\n':
+		return gbLF
+	case r == 0x200D:
+		return gbZWJ
+	case r == 0x200C,
+		r >= 0xFE00 && r <= 0xFE0F,   // variation selectors
+		r >= 0x1F3FB && r <= 0x1F3FF, // emoji skin tone modifiers
+		r >= 0xE0020 && r <= 0xE007F, // tags
+		r >= 0xE0100 && r <= 0xE01EF, // variation selectors supplement
+		unicode.In(r, unicode.Mn, unicode.Me):
+		return gbExtend
+	case unicode.In(r, unicode.Cc, unicode.Zl, unicode.Zp), r == 0x200B, r == 0xFEFF:
+		return gbControl
+	case unicode.Is(unicode.Mc, r):
+		return gbSpacingMark
+	case r >= 0x1F1E6 && r <= 0x1F1FF:
+		return gbRegionalIndicator
+	case r >= 0x1100 && r <= 0x115F, r >= 0xA960 && r <= 0xA97C:
+		return gbL
+	case r >= 0x1160 && r <= 0x11A7, r >= 0xD7B0 && r <= 0xD7C6:
+		return gbV
+	case r >= 0x11A8 && r <= 0x11FF, r >= 0xD7CB && r <= 0xD7FB:
+		return gbT
+	case r >= 0xAC00 && r <= 0xD7A3:
+		if (r-0xAC00)%28 == 0 {
+			return gbLV
+		}
+		return gbLVT
+	case isPictographic(r):
+		return gbPictographic
+	default:
+		return gbOther
+	}
+}
+
+// isPictographic approximates the Extended_Pictographic property.
+func isPictographic(r rune) bool {
+	switch {
+	case r == 0x00A9, r == 0x00AE, r == 0x203C, r == 0x2049, r == 0x2122, r == 0x2139:
+		return true
+	case r >= 0x2194 && r <= 0x21FF,
+		r >= 0x2300 && r <= 0x23FF,
+		r >= 0x2600 && r <= 0x27BF,
+		r >= 0x2B00 && r <= 0x2BFF,
+		r >= 0x1F000 && r <= 0x1FAFF:
+		return r < 0x1F1E6 || r > 0x1F1FF
+	default:
+		return unicode.Is(unicode.So, r) && r > utf8.RuneSelf
+	}
+}
+
+// DisplayWidth returns the number of terminal columns taken by s, following
+// Unicode UAX #11 (East Asian Width) like wcwidth: East Asian wide and
+// fullwidth characters (CJK ideographs, kana, Hangul syllables, fullwidth
+// forms) and emoji take two columns, combining marks, format characters,
+// control characters, emoji skin tone modifiers and Hangul medial and final
+// jamo take none, and every other character takes one. Width is summed per
+// code point, so emoji ZWJ sequences count every emoji they join.
+func DisplayWidth(s string) int {
+	n := 0
+	for _, r := range s {
+		switch {
+		case r == 0x200B, r >= 0x1160 && r <= 0x11FF,
+			r >= 0x1F3FB && r <= 0x1F3FF, // emoji skin tone modifiers
+			unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf, unicode.Cc):
+		case unicode.Is(eastAsianWide, r):
+			n += 2
+		default:
+			n++
+		}
+	}
+	return n
+}
+
+// eastAsianWide approximates the characters whose East_Asian_Width property
+// is Wide or Fullwidth.
+var eastAsianWide = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x1100, Hi: 0x115F, Stride: 1},
+		{Lo: 0x231A, Hi: 0x231B, Stride: 1},
+		{Lo: 0x2329, Hi: 0x232A, Stride: 1},
+		{Lo: 0x23E9, Hi: 0x23EC, Stride: 1},
+		{Lo: 0x23F0, Hi: 0x23F3, Stride: 3},
+		{Lo: 0x25FD, Hi: 0x25FE, Stride: 1},
+		{Lo: 0x2614, Hi: 0x2615, Stride: 1},
+		{Lo: 0x2648, Hi: 0x2653, Stride: 1},
+		{Lo: 0x267F, Hi: 0x2693, Stride: 20},
+		{Lo: 0x26A1, Hi: 0x26A1, Stride: 1},
+		{Lo: 0x26AA, Hi: 0x26AB, Stride: 1},
+		{Lo: 0x26BD, Hi: 0x26BE, Stride: 1},
+		{Lo: 0x26C4, Hi: 0x26C5, Stride: 1},
+		{Lo: 0x26CE, Hi: 0x26D4, Stride: 6},
+		{Lo: 0x26EA, Hi: 0x26EA, Stride: 1},
+		{Lo: 0x26F2, Hi: 0x26F3, Stride: 1},
+		{Lo: 0x26F5, Hi: 0x26FA, Stride: 5},
+		{Lo: 0x26FD, Hi: 0x26FD, Stride: 1},
+		{Lo: 0x2705, Hi: 0x2705, Stride: 1},
+		{Lo: 0x270A, Hi: 0x270B, Stride: 1},
+		{Lo: 0x2728, Hi: 0x2728, Stride: 1},
+		{Lo: 0x274C, Hi: 0x274E, Stride: 2},
+		{Lo: 0x2753, Hi: 0x2755, Stride: 1},
+		{Lo: 0x2757, Hi: 0x2757, Stride: 1},
+		{Lo: 0x2795, Hi: 0x2797, Stride: 1},
+		{Lo: 0x27B0, Hi: 0x27BF, Stride: 15},
+		{Lo: 0x2B1B, Hi: 0x2B1C, Stride: 1},
+		{Lo: 0x2B50, Hi: 0x2B55, Stride: 5},
+		{Lo: 0x2E80, Hi: 0x303E, Stride: 1},
+		{Lo: 0x3041, Hi: 0x33FF, Stride: 1},
+		{Lo: 0x3400, Hi: 0x4DBF, Stride: 1},
+		{Lo: 0x4E00, Hi: 0xA4CF, Stride: 1},
+		{Lo: 0xA960, Hi: 0xA97F, Stride: 1},
+		{Lo: 0xAC00, Hi: 0xD7A3, Stride: 1},
+		{Lo: 0xF900, Hi: 0xFAFF, Stride: 1},
+		{Lo: 0xFE10, Hi: 0xFE19, Stride: 1},
+		{Lo: 0xFE30, Hi: 0xFE6F, Stride: 1},
+		{Lo: 0xFF00, Hi: 0xFF60, Stride: 1},
+		{Lo: 0xFFE0, Hi: 0xFFE6, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x16FE0, Hi: 0x16FE4, Stride: 1},
+		{Lo: 0x17000, Hi: 0x18CFF, Stride: 1},
+		{Lo: 0x1B000, Hi: 0x1B2FF, Stride: 1},
+		{Lo: 0x1F004, Hi: 0x1F004, Stride: 1},
+		{Lo: 0x1F0CF, Hi: 0x1F0CF, Stride: 1},
+		{Lo: 0x1F18E, Hi: 0x1F18E, Stride: 1},
+		{Lo: 0x1F191, Hi: 0x1F19A, Stride: 1},
+		{Lo: 0x1F200, Hi: 0x1F251, Stride: 1},
+		{Lo: 0x1F300, Hi: 0x1F64F, Stride: 1},
+		{Lo: 0x1F680, Hi: 0x1F6FF, Stride: 1},
+		{Lo: 0x1F7E0, Hi: 0x1F7EB, Stride: 1},
+		{Lo: 0x1F90C, Hi: 0x1F9FF, Stride: 1},
+		{Lo: 0x1FA70, Hi: 0x1FAFF, Stride: 1},
+		{Lo: 0x20000, Hi: 0x2FFFD, Stride: 1},
+		{Lo: 0x30000, Hi: 0x3FFFD, Stride: 1},
+	},
+}
@@ -0,0 +1,488 @@
+package jsonschema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alexisvisco/valid"
+	"github.com/alexisvisco/valid/is"
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// Validator validates JSON documents against a compiled Schema. It is safe
+// for concurrent use.
+type Validator struct {
+	root *node
+}
+
+// CompileJSON parses a JSON Schema document and compiles it.
+func CompileJSON(data []byte) (*Validator, error) {
+	var s Schema
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("jsonschema: %w", err)
+	}
+	return Compile(&s)
+}
+
+// Compile compiles s into a Validator. The supported keywords are type,
+// properties, required, additionalProperties, items, enum, const,
+// minLength/maxLength, pattern, minimum/maximum, exclusiveMinimum/
+// exclusiveMaximum, multipleOf, minItems/maxItems, uniqueItems,
+// minProperties/maxProperties, allOf, $ref to "#" or "#/$defs/..." and format.
+// Other keywords are ignored.
+//
+// Keywords are checked with the rules of package is, so violations carry the
+// same codes and messages as Go validation: is.MinLength-like rules count
+// runes, pattern uses is.Matches (RE2 syntax, unanchored), and the formats
+// "email", "uuid", "uri", "date-time", "date", "ipv4" and "ipv6" use is.Email,
+// is.UUID, is.URL, is.TimeParser and is.IPParser. Other formats are ignored.
+//
+// Compile returns an error for invalid patterns, unresolvable references and
+// references that resolve to themselves without descending into the value
+// (e.g. a $defs entry whose $ref is itself).
+func Compile(s *Schema) (*Validator, error) {
+	c := &compiler{root: s, nodes: map[*Schema]*node{}}
+	root, err := c.compile(s)
+	if err != nil {
+		return nil, err
+	}
+	state := map[*node]int{}
+	for _, n := range c.nodes {
+		if err := checkCycles(n, state); err != nil {
+			return nil, err
+		}
+	}
+	return &Validator{root: root}, nil
+}
+
+// checkCycles returns an error if n reaches itself through $ref and allOf,
+// which apply to the same value and would make validation recurse forever.
+// state marks the nodes being visited (1) and those known to be acyclic (2).
+func checkCycles(n *node, state map[*node]int) error {
+	if n == nil {
+		return nil
+	}
+	switch state[n] {
+	case 1:
+		return fmt.Errorf("jsonschema: circular $ref or allOf")
+	case 2:
+		return nil
+	}
+	state[n] = 1
+	if err := checkCycles(n.ref, state); err != nil {
+		return err
+	}
+	for _, sub := range n.allOf {
+		if err := checkCycles(sub, state); err != nil {
+			return err
+		}
+	}
+	state[n] = 2
+	return nil
+}
+
+// Validate validates doc, a value decoded by encoding/json into any (objects
+// as map[string]any, arrays as []any, numbers as float64 or json.Number).
+// It returns a *valid.Error whose paths are JSON pointers ("/items/0/sku",
+// "" for the document itself), or nil if doc is valid.
+//
+// Like valid.Field, the rules of a value are short-circuited: a value of the
+// wrong type, or its first violated keyword, is reported once.
+func (v *Validator) Validate(ctx context.Context, doc any) error {
+	fields := v.root.validate(ctx, "", doc)
+	if len(fields) == 0 {
+		return nil
+	}
+	return &valid.Error{Fields: fields}
+}
+
+// node is a compiled Schema.
+type node struct {
+	never      bool
+	ref        *node
+	types      []string
+	rules      []rule
+	properties map[string]*node
+	required   []string
+	additional *node
+	items      *node
+	allOf      []*node
+}
+
+// rule is a keyword rule, evaluated only against values of its JSON type
+// ("" for any value).
+type rule struct {
+	typ  string
+	rule is.Rule
+}
+
+type compiler struct {
+	root  *Schema
+	nodes map[*Schema]*node
+}
+
+func (c *compiler) compile(s *Schema) (*node, error) {
+	if s == nil {
+		return nil, nil
+	}
+	if n, ok := c.nodes[s]; ok {
+		return n, nil
+	}
+	n := &node{never: s.never, types: s.Type}
+	c.nodes[s] = n
+
+	if s.Ref != "" {
+		target, err := c.resolve(s.Ref)
+		if err != nil {
+			return nil, err
+		}
+		if n.ref, err = c.compile(target); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := c.rules(n, s); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if n.items, err = c.compile(s.Items); err != nil {
+		return nil, err
+	}
+	if n.additional, err = c.compile(s.AdditionalProperties); err != nil {
+		return nil, err
+	}
+	if len(s.Properties) > 0 {
+		n.properties = make(map[string]*node, len(s.Properties))
+		for name, p := range s.Properties {
+			if n.properties[name], err = c.compile(p); err != nil {
+				return nil, err
+			}
+		}
+	}
+	n.required = s.Required
+	for _, sub := range s.AllOf {
+		compiled, err := c.compile(sub)
+		if err != nil {
+			return nil, err
+		}
+		n.allOf = append(n.allOf, compiled)
+	}
+	return n, nil
+}
+
+// resolve returns the schema referenced by ref.
+func (c *compiler) resolve(ref string) (*Schema, error) {
+	if ref == "#" {
+		return c.root, nil
+	}
+	if name, ok := strings.CutPrefix(ref, "#/$defs/"); ok {
+		name = strings.NewReplacer("~1", "/", "~0", "~").Replace(name)
+		if def := c.root.Defs[name]; def != nil {
+			return def, nil
+		}
+	}
+	return nil, fmt.Errorf("jsonschema: cannot resolve $ref %q", ref)
+}
+
+// rules compiles the keywords of s that constrain a single value.
+func (c *compiler) rules(n *node, s *Schema) error {
+	add := func(typ string, r is.Rule) { n.rules = append(n.rules, rule{typ: typ, rule: r}) }
+
+	if s.Enum != nil {
+		add("", enumRule(s.Enum))
+	}
+	if s.Const != nil {
+		add("", constRule(s.Const))
+	}
+
+	if s.MinLength != nil {
+		add("string", is.MinLengthIn(is.Runes, *s.MinLength))
+	}
+	if s.MaxLength != nil {
+		add("string", is.MaxLengthIn(is.Runes, *s.MaxLength))
+	}
+	if s.Pattern != "" {
+		if _, err := regexp.Compile(s.Pattern); err != nil {
+			return fmt.Errorf("jsonschema: invalid pattern %q: %w", s.Pattern, err)
+		}
+		add("string", is.Matches(s.Pattern))
+	}
+	if r := formatRule(s.Format); r != nil {
+		add("string", r)
+	}
+
+	for _, bound := range []struct {
+		limit json.Number
+		rule  func(limit any) is.Rule
+	}{
+		{s.Minimum, numberRule(is.Min[int64], is.Min[float64])},
+		{s.Maximum, numberRule(is.Max[int64], is.Max[float64])},
+		{s.ExclusiveMinimum, numberRule(is.GreaterThan[int64], is.GreaterThan[float64])},
+		{s.ExclusiveMaximum, numberRule(is.LessThan[int64], is.LessThan[float64])},
+		{s.MultipleOf, numberRule(is.MultipleOf[int64], is.MultipleOf[float64])},
+	} {
+		if bound.limit == "" {
+			continue
+		}
+		limit, err := numberLimit(bound.limit)
+		if err != nil {
+			return err
+		}
+		add("number", bound.rule(limit))
+	}
+
+	if s.MinItems != nil {
+		add("array", is.MinItems(*s.MinItems))
+	}
+	if s.MaxItems != nil {
+		add("array", is.MaxItems(*s.MaxItems))
+	}
+	if s.UniqueItems {
+		add("array", is.UniqueBy(canonical))
+	}
+	if s.MinProperties != nil {
+		add("object", is.MinItems(*s.MinProperties))
+	}
+	if s.MaxProperties != nil {
+		add("object", is.MaxItems(*s.MaxProperties))
+	}
+	return nil
+}
+
+// numberRule returns a constructor of rules for int64 or float64 limits.
+func numberRule(forInt func(int64) is.Rule, forFloat func(float64) is.Rule) func(limit any) is.Rule {
+	return func(limit any) is.Rule {
+		if n, ok := limit.(int64); ok {
+			return forInt(n)
+		}
+		return forFloat(limit.(float64))
+	}
+}
+
+// numberLimit converts n to an int64 when it is an integer in range, or to a
+// float64.
+func numberLimit(n json.Number) (any, error) {
+	if i, err := n.Int64(); err == nil {
+		return i, nil
+	}
+	f, err := n.Float64()
+	if err != nil {
+		return nil, fmt.Errorf("jsonschema: invalid number %q", n)
+	}
+	return f, nil
+}
+
+// formatRule returns the rule checking format, or nil for unknown formats.
+func formatRule(format string) is.Rule {
+	switch format {
+	case "email":
+		return is.Email
+	case "uuid":
+		return is.UUID
+	case "uri":
+		return is.URL
+	case "date-time":
+		return is.TimeParser(time.RFC3339).Rule()
+	case "date":
+		return is.TimeParser(time.DateOnly).Rule()
+	case "ipv4":
+		return is.IPParser.Rule(ipRule(netip.Addr.Is4))
+	case "ipv6":
+		return is.IPParser.Rule(ipRule(netip.Addr.Is6))
+	}
+	return nil
+}
+
+// ipRule reports is.ViolationIP for addresses of another family, checked by
+// family, and for IPv6 addresses with a zone, which the formats do not allow.
+func ipRule(family func(netip.Addr) bool) is.Rule {
+	return func(_ context.Context, value any) *is.Violation {
+		if addr, ok := value.(netip.Addr); ok && family(addr) && addr.Zone() == "" {
+			return nil
+		}
+		return &is.Violation{Code: is.ViolationIP, Message: is.FormatMessage(is.ViolationIP, nil)}
+	}
+}
+
+// enumRule reports is.ViolationOneOf for values not equal to one of values.
+func enumRule(values []any) is.Rule {
+	keys := make([]string, len(values))
+	texts := make([]string, len(values))
+	for i, v := range values {
+		keys[i] = canonical(v)
+		texts[i] = fmt.Sprint(v)
+	}
+	message := is.FormatMessage(is.ViolationOneOf, map[string]any{"values": strings.Join(texts, ", ")})
+	return func(_ context.Context, value any) *is.Violation {
+		if slices.Contains(keys, canonical(value)) {
+			return nil
+		}
+		return &is.Violation{Code: is.ViolationOneOf, Message: message}
+	}
+}
+
+// constRule reports is.ViolationEQ for values not equal to want.
+func constRule(want any) is.Rule {
+	key := canonical(want)
+	message := is.FormatMessage(is.ViolationEQ, map[string]any{"value": want})
+	return func(_ context.Context, value any) *is.Violation {
+		if canonical(value) == key {
+			return nil
+		}
+		return &is.Violation{Code: is.ViolationEQ, Message: message}
+	}
+}
+
+// canonical returns a key identifying a JSON value, so that equal values
+// (e.g. 1, 1.0 and json.Number("1"), or objects with keys in any order) have
+// the same key.
+func canonical(v any) string {
+	switch v := v.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		var b strings.Builder
+		b.WriteByte('{')
+		for i, k := range keys {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(strconv.Quote(k) + ":" + canonical(v[k]))
+		}
+		b.WriteByte('}')
+		return b.String()
+	case []any:
+		parts := make([]string, len(v))
+		for i, item := range v {
+			parts[i] = canonical(item)
+		}
+		return "[" + strings.Join(parts, ",") + "]"
+	case string:
+		return strconv.Quote(v)
+	case bool, nil:
+		return fmt.Sprint(v)
+	}
+	if r, ok := ishelper.ToRat(v); ok {
+		return r.RatString()
+	}
+	return fmt.Sprintf("%#v", v)
+}
+
+// validate validates value at path against n.
+func (n *node) validate(ctx context.Context, path string, value any) []valid.FieldError {
+	if n.never {
+		return []valid.FieldError{fieldError(path, &is.Violation{
+			Code:    is.ViolationUnknownField,
+			Message: is.FormatMessage(is.ViolationUnknownField, nil),
+		})}
+	}
+	if n.ref != nil {
+		if errs := n.ref.validate(ctx, path, value); len(errs) > 0 {
+			return errs
+		}
+	}
+
+	typ := jsonType(value)
+	if len(n.types) > 0 && !slices.Contains(n.types, typ) && !(typ == "integer" && slices.Contains(n.types, "number")) {
+		params := map[string]any{"type": strings.Join(n.types, " or ")}
+		return []valid.FieldError{fieldError(path, &is.Violation{
+			Code:    is.ViolationType,
+			Message: is.FormatMessage(is.ViolationType, params),
+			Params:  params,
+		})}
+	}
+	if typ == "integer" {
+		typ = "number"
+	}
+	for _, r := range n.rules {
+		if r.typ != "" && r.typ != typ {
+			continue
+		}
+		if v := r.rule(ctx, value); v != nil {
+			return []valid.FieldError{fieldError(path, v)}
+		}
+	}
+
+	var errs []valid.FieldError
+	switch value := value.(type) {
+	case map[string]any:
+		for _, name := range n.required {
+			if _, ok := value[name]; !ok {
+				errs = append(errs, fieldError(pointer(path, name), &is.Violation{
+					Code:    is.ViolationRequired,
+					Message: is.FormatMessage(is.ViolationRequired, nil),
+				}))
+			}
+		}
+		names := make([]string, 0, len(value))
+		for name := range value {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			p, ok := n.properties[name]
+			if !ok {
+				p = n.additional
+			}
+			if p != nil {
+				errs = append(errs, p.validate(ctx, pointer(path, name), value[name])...)
+			}
+		}
+	case []any:
+		if n.items != nil {
+			for i, item := range value {
+				errs = append(errs, n.items.validate(ctx, pointer(path, strconv.Itoa(i)), item)...)
+			}
+		}
+	}
+	for _, sub := range n.allOf {
+		errs = append(errs, sub.validate(ctx, path, value)...)
+	}
+	return errs
+}
+
+// jsonType returns the JSON Schema type of a decoded JSON value, "integer"
+// for numbers without a fractional part, or "" for other Go values.
+func jsonType(value any) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	}
+	r, ok := ishelper.ToRat(value)
+	if !ok {
+		return ""
+	}
+	if r.IsInt() {
+		return "integer"
+	}
+	return "number"
+}
+
+// pointer appends a reference token to the JSON pointer path.
+func pointer(path, token string) string {
+	return path + "/" + strings.NewReplacer("~", "~0", "/", "~1").Replace(token)
+}
+
+func fieldError(path string, v *is.Violation) valid.FieldError {
+	return valid.FieldError{Path: path, Code: string(v.Code), Message: v.Message, Params: v.Params}
+}
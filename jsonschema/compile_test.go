@@ -0,0 +1,167 @@
+package jsonschema_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/alexisvisco/valid"
+	"github.com/alexisvisco/valid/is"
+	"github.com/alexisvisco/valid/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const customFields = `{
+	"$schema": "https://json-schema.org/draft/2020-12/schema",
+	"type": "object",
+	"properties": {
+		"email": {"type": "string", "format": "email"},
+		"name": {"type": "string", "minLength": 2, "maxLength": 5},
+		"age": {"type": "integer", "minimum": 18, "exclusiveMaximum": 130},
+		"ratio": {"type": "number", "multipleOf": 0.25},
+		"plan": {"enum": ["free", "pro", 3]},
+		"kind": {"const": "customer"},
+		"code": {"type": "string", "pattern": "^[A-Z]{3}$"},
+		"tags": {"type": "array", "items": {"type": "string"}, "minItems": 1, "uniqueItems": true},
+		"id": {"type": ["string", "null"], "format": "uuid"},
+		"address": {"$ref": "#/$defs/address"},
+		"parent": {"$ref": "#"}
+	},
+	"required": ["email", "name"],
+	"additionalProperties": false,
+	"$defs": {
+		"address": {
+			"type": "object",
+			"properties": {"city": {"type": "string"}, "a/b": {"type": "boolean"}},
+			"required": ["city"],
+			"x-owner": "billing"
+		}
+	}
+}`
+
+func decode(t *testing.T, doc string) map[string]any {
+	t.Helper()
+	var m map[string]any
+	require.NoError(t, json.Unmarshal([]byte(doc), &m))
+	return m
+}
+
+func TestCompile(t *testing.T) {
+	t.Parallel()
+
+	v, err := jsonschema.CompileJSON([]byte(customFields))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	t.Run("valid document", func(t *testing.T) {
+		t.Parallel()
+		err := v.Validate(ctx, decode(t, `{
+			"email": "a@example.com", "name": "Zoé", "age": 18, "ratio": 1.5, "plan": 3,
+			"kind": "customer", "code": "ABC", "tags": ["a", "b"], "id": null,
+			"address": {"city": "Paris"}, "parent": {"email": "b@example.com", "name": "Bo"}
+		}`))
+		require.NoError(t, err)
+	})
+
+	t.Run("every violation at its JSON pointer", func(t *testing.T) {
+		t.Parallel()
+		err := v.Validate(ctx, decode(t, `{
+			"name": "x", "age": 130, "ratio": 0.3, "plan": "gold", "kind": "admin", "code": "abc",
+			"tags": ["a", "a"], "id": 4, "address": {"a/b": "yes"}, "parent": {"email": "nope", "name": "Bo"},
+			"extra": true
+		}`))
+		ve := valid.As(err)
+		require.NotNil(t, ve)
+		assert.Equal(t, []valid.FieldError{
+			{Path: "/email", Code: string(is.ViolationRequired), Message: "is required"},
+			{Path: "/address/city", Code: string(is.ViolationRequired), Message: "is required"},
+			{Path: "/address/a~1b", Code: string(is.ViolationType), Message: "must be of type boolean", Params: map[string]any{"type": "boolean"}},
+			{Path: "/age", Code: string(is.ViolationLT), Message: "must be < 130"},
+			{Path: "/code", Code: string(is.ViolationMatches), Message: "must match pattern ^[A-Z]{3}$"},
+			{Path: "/extra", Code: string(is.ViolationUnknownField), Message: "is not allowed"},
+			{Path: "/id", Code: string(is.ViolationType), Message: "must be of type string or null", Params: map[string]any{"type": "string or null"}},
+			{Path: "/kind", Code: string(is.ViolationEQ), Message: "must be = customer"},
+			{Path: "/name", Code: string(is.ViolationMinLength), Message: "length must be >= 2"},
+			{Path: "/parent/email", Code: string(is.ViolationEmail), Message: "must be a valid email"},
+			{Path: "/plan", Code: string(is.ViolationOneOf), Message: "must be one of free, pro, 3"},
+			{Path: "/ratio", Code: string(is.ViolationMultipleOf), Message: "must be a multiple of 0.25"},
+			{Path: "/tags", Code: string(is.ViolationUnique), Message: "must be unique", Params: map[string]any{"indices": []int{1}}},
+		}, ve.Fields)
+	})
+
+	t.Run("numbers decoded as json.Number", func(t *testing.T) {
+		t.Parallel()
+		dec := json.NewDecoder(strings.NewReader(`{"email": "a@example.com", "name": "Al", "age": 17.0, "plan": 3.0}`))
+		dec.UseNumber()
+		var doc any
+		require.NoError(t, dec.Decode(&doc))
+		ve := valid.As(v.Validate(ctx, doc))
+		require.NotNil(t, ve)
+		assert.Equal(t, []valid.FieldError{{Path: "/age", Code: string(is.ViolationMin), Message: "must be >= 18"}}, ve.Fields)
+	})
+
+	t.Run("document of the wrong type", func(t *testing.T) {
+		t.Parallel()
+		ve := valid.As(v.Validate(ctx, []any{}))
+		require.NotNil(t, ve)
+		assert.Equal(t, "", ve.Fields[0].Path)
+		assert.Equal(t, string(is.ViolationType), ve.Fields[0].Code)
+	})
+}
+
+func TestCompileErrors(t *testing.T) {
+	t.Parallel()
+
+	_, err := jsonschema.CompileJSON([]byte(`{"pattern": "("}`))
+	require.ErrorContains(t, err, "invalid pattern")
+	_, err = jsonschema.CompileJSON([]byte(`{"$ref": "#/$defs/missing"}`))
+	require.ErrorContains(t, err, "cannot resolve $ref")
+	_, err = jsonschema.CompileJSON([]byte(`{"$ref": "#/$defs/a", "$defs": {"a": {"$ref": "#/$defs/a"}}}`))
+	require.ErrorContains(t, err, "circular")
+	_, err = jsonschema.CompileJSON([]byte(`{"$ref": "#"}`))
+	require.ErrorContains(t, err, "circular")
+	_, err = jsonschema.CompileJSON([]byte(`{"allOf": [{"$ref": "#/$defs/b"}], "$defs": {"b": {"allOf": [{"$ref": "#"}]}}}`))
+	require.ErrorContains(t, err, "circular")
+	_, err = jsonschema.CompileJSON([]byte(`{"type": 1}`))
+	require.Error(t, err)
+}
+
+func TestCompileIPFormats(t *testing.T) {
+	t.Parallel()
+
+	v, err := jsonschema.CompileJSON([]byte(`{"type": "object", "properties": {
+		"v4": {"type": "string", "format": "ipv4"},
+		"v6": {"type": "string", "format": "ipv6"}
+	}}`))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	require.NoError(t, v.Validate(ctx, decode(t, `{"v4": "192.0.2.1", "v6": "2001:db8::1"}`)))
+	require.NoError(t, v.Validate(ctx, decode(t, `{"v6": "::ffff:192.0.2.1"}`)))
+	for _, doc := range []string{`{"v4": "::1"}`, `{"v4": "::ffff:192.0.2.1"}`, `{"v6": "192.0.2.1"}`, `{"v6": "fe80::1%eth0"}`, `{"v4": "localhost"}`} {
+		ve := valid.As(v.Validate(ctx, decode(t, doc)))
+		require.NotNil(t, ve, doc)
+		assert.Equal(t, string(is.ViolationIP), ve.Fields[0].Code, doc)
+	}
+}
+
+func TestSchemaJSON(t *testing.T) {
+	t.Parallel()
+
+	var s jsonschema.Schema
+	require.NoError(t, json.Unmarshal([]byte(customFields), &s))
+	assert.Equal(t, "billing", s.Defs["address"].Extensions["x-owner"])
+
+	got, err := json.Marshal(&s)
+	require.NoError(t, err)
+	assert.JSONEq(t, customFields, string(got))
+
+	// Exported schemas validate the documents accepted by Valid.
+	v, err := jsonschema.Compile(jsonschema.For[*signup]())
+	require.NoError(t, err)
+	ve := valid.As(v.Validate(context.Background(), map[string]any{"name": "Al", "email": "x", "home": map[string]any{}}))
+	require.NotNil(t, ve)
+	assert.Equal(t, []string{"/email", "/home/city"}, []string{ve.Fields[0].Path, ve.Fields[1].Path})
+}
@@ -0,0 +1,489 @@
+package jsonschema
+
+import (
+	"encoding"
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alexisvisco/valid"
+	"github.com/alexisvisco/valid/is"
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// For returns the JSON Schema of T, derived from the fields and rules
+// declared by its Valid method (see valid.Describe). Named struct types
+// validated through valid.Nested are written once under $defs and referenced
+// with $ref.
+//
+// Rules are mapped to the closest keyword: is.MinLength → minLength (or
+// minItems / minProperties depending on the field type), is.Between →
+// minimum/maximum, is.OneOf → enum, is.Matches → pattern, is.Email →
+// format "email", is.Required → the "required" list of the parent object,
+// and so on. Rules without an equivalent keyword (custom rules, is.Normalized,
+// valid.Parse) are left out, so the schema accepts a superset of the values
+// accepted by Valid.
+//
+// minLength and maxLength count code points while is.MinLength and
+// is.MaxLength count bytes: string lengths are exact for is.MinLengthIn and
+// is.MaxLengthIn in is.Runes, and loosened to the implied code point count
+// for other units (is.MinLength(8) → minLength 2). Patterns are translated
+// with ECMAScriptPattern and left out when they have no equivalent.
+func For[T valid.Validatable]() *Schema {
+	t := reflect.TypeFor[T]()
+	var v any
+	if t.Kind() == reflect.Pointer {
+		v = reflect.New(t.Elem()).Interface()
+	} else {
+		var zero T
+		v = zero
+	}
+	s := Generate(t, valid.Describe(valid.Nested("", v)))
+	s.Dialect = Draft202012
+	return s
+}
+
+// Generate returns the schema of a value of type t validated by fields, as
+// returned by valid.Describe. The returned schema has no $schema keyword.
+func Generate(t reflect.Type, fields []valid.FieldSpec) *Schema {
+	return GenerateWith(t, fields, Options{})
+}
+
+// Options configures GenerateWith.
+type Options struct {
+	// ErrorCodes adds an "x-error-codes" extension to every field with rules,
+	// listing the violation codes the field can produce.
+	ErrorCodes bool
+	// Nullable adds "null" to the type of ishelper.Optional fields.
+	Nullable bool
+	// RuleSpecs adds an "x-rules" extension to every field with rules, holding
+	// their []is.RuleSpec, for generators building on the schema. It is meant
+	// for use in Go and may not marshal.
+	RuleSpecs bool
+	// DefName returns the $defs key of the named type t, instead of its name.
+	// Keys are still made unique with a numeric suffix.
+	DefName func(t reflect.Type) string
+}
+
+// GenerateWith is like Generate with options.
+func GenerateWith(t reflect.Type, fields []valid.FieldSpec, opts Options) *Schema {
+	g := &generator{opts: opts, defs: map[string]*Schema{}, names: map[reflect.Type]string{}, filled: map[string]bool{}}
+	root := &Schema{}
+	if t != nil {
+		g.root = indirect(t)
+		g.typeOf(root, t)
+	}
+	g.fields(root, fields)
+	if len(g.defs) > 0 {
+		root.Defs = g.defs
+	}
+	return root
+}
+
+type generator struct {
+	opts  Options
+	root  reflect.Type
+	defs  map[string]*Schema
+	names map[reflect.Type]string
+	// filled holds the $defs keys whose properties were added.
+	filled map[string]bool
+}
+
+var (
+	timeType            = reflect.TypeFor[time.Time]()
+	textMarshalerType   = reflect.TypeFor[encoding.TextMarshaler]()
+	jsonMarshalerType   = reflect.TypeFor[json.Marshaler]()
+	optionalType        = reflect.TypeFor[ishelper.Optional]()
+	invalidDefNameChars = regexp.MustCompile(`[^A-Za-z0-9_.-]+`)
+)
+
+// fields adds the schemas of fields to s.
+func (g *generator) fields(s *Schema, fields []valid.FieldSpec) {
+	for _, f := range fields {
+		g.field(s, strings.Split(f.Path, "."), f)
+	}
+}
+
+// field adds the schema of f to s, at the property or item reached by path.
+func (g *generator) field(s *Schema, path []string, f valid.FieldSpec) {
+	s = g.target(s)
+	name := path[0]
+	var child *Schema
+	if name == "*" {
+		if s.Items == nil {
+			s.Items = &Schema{}
+		}
+		child = s.Items
+	} else {
+		if s.Type == nil && s.Ref == "" {
+			s.Type = Types{"object"}
+		}
+		if s.Properties == nil {
+			s.Properties = map[string]*Schema{}
+		}
+		if s.Properties[name] == nil {
+			s.Properties[name] = &Schema{}
+		}
+		child = s.Properties[name]
+	}
+	if len(path) > 1 {
+		g.field(child, path[1:], f)
+		return
+	}
+
+	g.typeOf(child, f.Type)
+	for _, r := range f.Rules {
+		if r.Name == "Required" && name != "*" && !slices.Contains(s.Required, name) {
+			s.Required = append(s.Required, name)
+		}
+		g.rule(child, r)
+	}
+	if g.opts.ErrorCodes {
+		addCodes(child, f.Rules)
+	}
+	if g.opts.RuleSpecs && len(f.Rules) > 0 {
+		if child.Extensions == nil {
+			child.Extensions = map[string]any{}
+		}
+		specs, _ := child.Extensions["x-rules"].([]is.RuleSpec)
+		child.Extensions["x-rules"] = append(specs, f.Rules...)
+	}
+	if name, ok := strings.CutPrefix(child.Ref, "#/$defs/"); ok {
+		// Definitions are filled by the first field of their type.
+		if g.filled[name] || len(f.Fields) == 0 {
+			return
+		}
+		g.filled[name] = true
+	}
+	g.fields(child, f.Fields)
+}
+
+// target returns the schema that holds the properties of s: its definition
+// when s is a $ref to $defs.
+func (g *generator) target(s *Schema) *Schema {
+	if name, ok := strings.CutPrefix(s.Ref, "#/$defs/"); ok {
+		return g.defs[name]
+	}
+	return s
+}
+
+// typeOf sets the type keywords of s from the Go type t, unless s already has
+// a type.
+func (g *generator) typeOf(s *Schema, t reflect.Type) {
+	if t == nil || s.Type != nil || s.Ref != "" {
+		return
+	}
+	nullable := false
+	for {
+		if t.Kind() == reflect.Pointer {
+			t = t.Elem()
+			continue
+		}
+		if t.Implements(optionalType) {
+			if m, ok := t.MethodByName("Unwrap"); ok && m.Type.NumOut() == 1 {
+				t = m.Type.Out(0)
+				nullable = g.opts.Nullable
+				continue
+			}
+		}
+		break
+	}
+	if nullable {
+		defer func() {
+			if s.Type != nil && !s.Type.Has("null") {
+				s.Type = append(s.Type, "null")
+			}
+		}()
+	}
+
+	if t == timeType {
+		s.Type, s.Format = Types{"string"}, "date-time"
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		s.Type = Types{"string"}
+	case reflect.Bool:
+		s.Type = Types{"boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		s.Type = Types{"integer"}
+	case reflect.Float32, reflect.Float64:
+		s.Type = Types{"number"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 && t.Kind() == reflect.Slice {
+			s.Type = Types{"string"}
+			return
+		}
+		s.Type = Types{"array"}
+		if s.Items == nil {
+			s.Items = &Schema{}
+		}
+		g.typeOf(s.Items, t.Elem())
+	case reflect.Map:
+		s.Type = Types{"object"}
+		if s.AdditionalProperties == nil {
+			s.AdditionalProperties = &Schema{}
+		}
+		g.typeOf(s.AdditionalProperties, t.Elem())
+	case reflect.Struct:
+		g.object(s, t)
+	}
+}
+
+// object sets s to the schema of the struct type t: a reference to its
+// definition for named types, or an inline object otherwise.
+func (g *generator) object(s *Schema, t reflect.Type) {
+	if t.Implements(textMarshalerType) || reflect.PointerTo(t).Implements(textMarshalerType) {
+		s.Type = Types{"string"}
+		return
+	}
+	if t.Implements(jsonMarshalerType) || reflect.PointerTo(t).Implements(jsonMarshalerType) {
+		// Encoded in a way the schema cannot tell.
+		return
+	}
+	if t.Name() == "" {
+		s.Type = Types{"object"}
+		return
+	}
+	if t == g.root {
+		if g.names[t] == "" {
+			// The root schema itself.
+			g.names[t] = "#"
+			s.Type = Types{"object"}
+			return
+		}
+		s.Ref = "#"
+		return
+	}
+	name, ok := g.names[t]
+	if !ok {
+		name = g.defName(t)
+		g.names[t] = name
+		g.defs[name] = &Schema{Type: Types{"object"}}
+	}
+	s.Ref = "#/$defs/" + name
+}
+
+// defName returns a unique $defs key for t.
+func (g *generator) defName(t reflect.Type) string {
+	base := invalidDefNameChars.ReplaceAllString(t.Name(), "_")
+	if g.opts.DefName != nil {
+		base = g.opts.DefName(t)
+	}
+	name := base
+	for i := 2; g.defs[name] != nil; i++ {
+		name = base + strconv.Itoa(i)
+	}
+	return name
+}
+
+// rule adds the keywords equivalent to r to s.
+func (g *generator) rule(s *Schema, r is.RuleSpec) {
+	switch r.Name {
+	case "Required":
+		if s.Type.Has("string") && s.MinLength == nil {
+			s.MinLength = intPtr(1)
+		}
+	case "MinLength":
+		g.length(s, is.Bytes, r.Params["min"], true)
+	case "MaxLength":
+		g.length(s, is.Bytes, r.Params["max"], false)
+	case "Length":
+		g.length(s, is.Bytes, r.Params["min"], true)
+		g.length(s, is.Bytes, r.Params["max"], false)
+	case "MinLengthIn":
+		g.length(s, r.Params["unit"], r.Params["min"], true)
+	case "MaxLengthIn":
+		g.length(s, r.Params["unit"], r.Params["max"], false)
+	case "LengthIn":
+		g.length(s, r.Params["unit"], r.Params["min"], true)
+		g.length(s, r.Params["unit"], r.Params["max"], false)
+	case "MinItems":
+		s.MinItems = toInt(r.Params["min"])
+	case "MaxItems":
+		s.MaxItems = toInt(r.Params["max"])
+	case "Min":
+		s.Minimum = number(r.Params["min"])
+	case "Max":
+		s.Maximum = number(r.Params["max"])
+	case "Between":
+		s.Minimum = number(r.Params["min"])
+		s.Maximum = number(r.Params["max"])
+	case "GreaterThan":
+		s.ExclusiveMinimum = number(r.Params["value"])
+	case "GreaterThanOrEqual":
+		s.Minimum = number(r.Params["value"])
+	case "LessThan":
+		s.ExclusiveMaximum = number(r.Params["value"])
+	case "LessThanOrEqual":
+		s.Maximum = number(r.Params["value"])
+	case "Positive":
+		s.ExclusiveMinimum = "0"
+	case "NonNegative":
+		s.Minimum = "0"
+	case "MultipleOf":
+		s.MultipleOf = number(r.Params["step"])
+	case "OneOf":
+		s.Enum, _ = r.Params["values"].([]any)
+	case "Equal":
+		s.Const = r.Params["value"]
+	case "Matches":
+		// JSON Schema has no flags, so patterns starting with (?i) and the
+		// like are left out.
+		if p, flags, ok := ECMAScriptPattern(fmtString(r.Params["pattern"])); ok && flags == "" {
+			g.pattern(s, p)
+		}
+	case "HasPrefix":
+		g.pattern(s, "^"+regexp.QuoteMeta(fmtString(r.Params["prefix"])))
+	case "HasSuffix":
+		g.pattern(s, regexp.QuoteMeta(fmtString(r.Params["suffix"]))+"$")
+	case "NotEmpty":
+		g.size(s, 1, true)
+	case "Latitude":
+		s.Minimum, s.Maximum = "-90", "90"
+	case "Longitude":
+		s.Minimum, s.Maximum = "-180", "180"
+	case "Email":
+		s.Format = "email"
+	case "URL":
+		s.Format = "uri"
+	case "UUID", "UUIDVersion", "NotNilUUID":
+		s.Format = "uuid"
+	case "Unique":
+		s.UniqueItems = true
+	}
+}
+
+// addCodes adds the violation codes of rules to the "x-error-codes"
+// extension of s.
+func addCodes(s *Schema, rules []is.RuleSpec) {
+	codes, _ := s.Extensions["x-error-codes"].([]string)
+	n := len(codes)
+	var walk func(rules []is.RuleSpec)
+	walk = func(rules []is.RuleSpec) {
+		for _, r := range rules {
+			for _, code := range append([]is.ViolationCode{r.Code}, r.OtherCodes...) {
+				if code != "" && !slices.Contains(codes, string(code)) {
+					codes = append(codes, string(code))
+				}
+			}
+			walk(r.Rules)
+		}
+	}
+	walk(rules)
+	if len(codes) == n {
+		return
+	}
+	if s.Extensions == nil {
+		s.Extensions = map[string]any{}
+	}
+	s.Extensions["x-error-codes"] = codes
+}
+
+// size sets the minimum or maximum length of s, depending on its type.
+func (g *generator) size(s *Schema, n any, isMin bool) {
+	v := toInt(n)
+	switch {
+	case s.Type.Has("array"):
+		if isMin {
+			s.MinItems = v
+		} else {
+			s.MaxItems = v
+		}
+	case s.Type.Has("object"):
+		if isMin {
+			s.MinProperties = v
+		} else {
+			s.MaxProperties = v
+		}
+	default:
+		if isMin {
+			s.MinLength = v
+		} else {
+			s.MaxLength = v
+		}
+	}
+}
+
+// length sets the minimum or maximum length of s from a length measured in
+// unit. Lists and maps are sized by size; for strings, whose minLength and
+// maxLength count code points, only the bound implied by the length in unit
+// is kept: a code point is 1 to 4 bytes, 1 or 2 UTF-16 code units and 0 to 2
+// columns wide, and a grapheme is one or more code points.
+func (g *generator) length(s *Schema, unit, n any, isMin bool) {
+	if s.Type.Has("array") || s.Type.Has("object") {
+		g.size(s, n, isMin)
+		return
+	}
+	v := toInt(n)
+	if v == nil {
+		return
+	}
+	u, _ := unit.(is.LengthUnit)
+	switch {
+	case isMin && u == is.Bytes:
+		v = intPtr((*v + 3) / 4)
+	case isMin && (u == is.UTF16 || u == is.Width):
+		v = intPtr((*v + 1) / 2)
+	case !isMin && (u == is.Graphemes || u == is.Width):
+		return
+	}
+	g.size(s, *v, isMin)
+}
+
+// pattern sets the pattern of s, adding further patterns to allOf since a
+// schema holds only one.
+func (g *generator) pattern(s *Schema, p string) {
+	if s.Pattern == "" {
+		s.Pattern = p
+		return
+	}
+	s.AllOf = append(s.AllOf, &Schema{Pattern: p})
+}
+
+// number writes v exactly as a JSON number, or returns "" if v is not a
+// number.
+func number(v any) json.Number {
+	r, ok := ishelper.ToDecimalRat(v)
+	if !ok {
+		return ""
+	}
+	if r.IsInt() {
+		return json.Number(r.Num().String())
+	}
+	if d, ok := ishelper.Decimals(r); ok {
+		return json.Number(r.FloatString(d))
+	}
+	f, _ := r.Float64()
+	return json.Number(strconv.FormatFloat(f, 'g', -1, 64))
+}
+
+func toInt(v any) *int {
+	r, ok := ishelper.ToRat(v)
+	if !ok || !r.IsInt() || !r.Num().IsInt64() {
+		return nil
+	}
+	return intPtr(int(new(big.Int).Set(r.Num()).Int64()))
+}
+
+func intPtr(n int) *int { return &n }
+
+func fmtString(v any) string {
+	s, _ := v.(string)
+	return s
+}
+
+// indirect returns the type pointed to by t, if t is a pointer.
+func indirect(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
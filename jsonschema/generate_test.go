@@ -0,0 +1,204 @@
+package jsonschema_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/alexisvisco/valid"
+	"github.com/alexisvisco/valid/is"
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/alexisvisco/valid/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type address struct {
+	City string `json:"city"`
+	Zip  string `json:"zip"`
+}
+
+func (a address) Valid(ctx context.Context) error {
+	return valid.Struct(ctx,
+		valid.Field("city", a.City, is.Required),
+		valid.Field("zip", a.Zip, is.Matches(`^\d{5}$`)),
+	)
+}
+
+type signup struct {
+	Name     string
+	Email    string
+	Age      int
+	Score    float64
+	Plan     string
+	Tags     []string
+	Nickname ishelper.Optional
+	Born     time.Time
+	Home     address
+	Work     *address
+	Referrer *signup
+}
+
+func (s *signup) Valid(ctx context.Context) error {
+	return valid.Struct(ctx,
+		valid.Field("name", s.Name, is.Required, is.MinLengthIn(is.Runes, 2), is.MaxLength(50)),
+		valid.Field("email", s.Email, is.Required, is.Email),
+		valid.Field("age", s.Age, is.Between(18, 130)),
+		valid.Field("score", s.Score, is.GreaterThan(0.5), is.MultipleOf(0.25)),
+		valid.Field("plan", s.Plan, is.OneOf("free", "pro"), is.HasPrefix("p")),
+		valid.Field("tags", s.Tags, is.MaxItems(5), is.Unique),
+		valid.Each("tags", s.Tags, is.MinLength(1)),
+		valid.Field("nickname", ishelper.Some("x"), is.MaxLength(20)),
+		valid.Field("born", s.Born),
+		valid.Nested("home", s.Home),
+		valid.Field("home", s.Home, is.Required),
+		valid.Nested("work", s.Work),
+		valid.Nested("referrer", s.Referrer),
+		valid.Field("meta.source", "", is.URL),
+	)
+}
+
+func TestFor(t *testing.T) {
+	t.Parallel()
+
+	got, err := json.Marshal(jsonschema.For[*signup]())
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"properties": {
+			"name": {"type": "string", "minLength": 2, "maxLength": 50},
+			"email": {"type": "string", "minLength": 1, "format": "email"},
+			"age": {"type": "integer", "minimum": 18, "maximum": 130},
+			"score": {"type": "number", "exclusiveMinimum": 0.5, "multipleOf": 0.25},
+			"plan": {"type": "string", "enum": ["free", "pro"], "pattern": "^p"},
+			"tags": {"type": "array", "items": {"type": "string", "minLength": 1}, "maxItems": 5, "uniqueItems": true},
+			"nickname": {"type": "string", "maxLength": 20},
+			"born": {"type": "string", "format": "date-time"},
+			"home": {"$ref": "#/$defs/address"},
+			"work": {"$ref": "#/$defs/address"},
+			"referrer": {"$ref": "#"},
+			"meta": {"type": "object", "properties": {"source": {"type": "string", "format": "uri"}}}
+		},
+		"required": ["name", "email", "home"],
+		"$defs": {
+			"address": {
+				"type": "object",
+				"properties": {
+					"city": {"type": "string", "minLength": 1},
+					"zip": {"type": "string", "pattern": "^\\d{5}$"}
+				},
+				"required": ["city"]
+			}
+		}
+	}`, string(got))
+}
+
+type cart struct {
+	Lines []address
+}
+
+func (c cart) Valid(ctx context.Context) error {
+	return valid.Struct(ctx,
+		valid.Nested("lines", c.Lines),
+		valid.Field("lines", c.Lines, is.MinLength(1)),
+		valid.UniqueBy("lines", c.Lines, "zip", func(a address) string { return a.Zip }),
+	)
+}
+
+func TestForSlices(t *testing.T) {
+	t.Parallel()
+
+	s := jsonschema.For[cart]()
+	lines := s.Properties["lines"]
+	require.NotNil(t, lines)
+	assert.Equal(t, jsonschema.Types{"array"}, lines.Type)
+	assert.Equal(t, 1, *lines.MinItems)
+	assert.Equal(t, "#/$defs/address", lines.Items.Ref)
+	assert.Contains(t, s.Defs, "address")
+}
+
+type lengths struct {
+	Code  string
+	Title string
+	Bio   string
+	Tags  []string
+	Slug  string
+	Lang  string
+}
+
+func (l lengths) Valid(ctx context.Context) error {
+	return valid.Struct(ctx,
+		valid.Field("code", l.Code, is.Length(8, 8)),
+		valid.Field("title", l.Title, is.LengthIn(is.Runes, 2, 80)),
+		valid.Field("bio", l.Bio, is.MinLengthIn(is.Graphemes, 3), is.MaxLengthIn(is.Graphemes, 300)),
+		valid.Field("tags", l.Tags, is.Length(1, 5)),
+		valid.Field("slug", l.Slug, is.MinLengthIn(is.UTF16, 3), is.Matches(`^[a-z\-]+\z`)),
+		valid.Field("lang", l.Lang, is.Matches(`(?i)^[a-z]+$`)),
+	)
+}
+
+func TestForLengthsAndPatterns(t *testing.T) {
+	t.Parallel()
+
+	got, err := json.Marshal(jsonschema.For[lengths]())
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"properties": {
+			"code": {"type": "string", "minLength": 2, "maxLength": 8},
+			"title": {"type": "string", "minLength": 2, "maxLength": 80},
+			"bio": {"type": "string", "minLength": 3},
+			"tags": {"type": "array", "items": {"type": "string"}, "minItems": 1, "maxItems": 5},
+			"slug": {"type": "string", "minLength": 2, "pattern": "^[a-z\\-]+$"},
+			"lang": {"type": "string"}
+		}
+	}`, string(got))
+}
+
+func TestECMAScriptPattern(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		pattern string
+		source  string
+		flags   string
+		ok      bool
+	}{
+		{pattern: `^\d{5}$`, source: `^\d{5}$`, ok: true},
+		{pattern: `(?i)^[a-z]+$`, source: `^[a-z]+$`, flags: "i", ok: true},
+		{pattern: `(?is)(?i)a.b`, source: `a.b`, flags: "is", ok: true},
+		{pattern: `\A(?P<year>\d{4})\z`, source: `^(?<year>\d{4})$`, ok: true},
+		{pattern: `\pL+\p{Greek}\PN\p{^Lu}`, source: `\p{L}+\p{Script=Greek}\P{N}\P{Lu}`, ok: true},
+		{pattern: `a{,2}\#\x{1F600}`, source: `a\{,2\}#\u{1F600}`, ok: true},
+		{pattern: `[]a][^]b]`, source: `[\]a][^\]b]`, ok: true},
+		{pattern: `a(?i)b`},
+		{pattern: `(?i:a)b`},
+		{pattern: `(?U)a+`},
+		{pattern: `\Qa.b\E`},
+		{pattern: `[[:alpha:]]`},
+		{pattern: `\101`},
+		{pattern: `(`},
+	}
+	for _, tt := range tests {
+		source, flags, ok := jsonschema.ECMAScriptPattern(tt.pattern)
+		assert.Equal(t, tt.ok, ok, tt.pattern)
+		assert.Equal(t, tt.source, source, tt.pattern)
+		assert.Equal(t, tt.flags, flags, tt.pattern)
+	}
+}
+
+func TestTypes(t *testing.T) {
+	t.Parallel()
+
+	var types jsonschema.Types
+	require.NoError(t, json.Unmarshal([]byte(`"string"`), &types))
+	assert.Equal(t, jsonschema.Types{"string"}, types)
+	require.NoError(t, json.Unmarshal([]byte(`["string","null"]`), &types))
+	assert.Equal(t, jsonschema.Types{"string", "null"}, types)
+	got, err := json.Marshal(types)
+	require.NoError(t, err)
+	assert.JSONEq(t, `["string","null"]`, string(got))
+}
@@ -0,0 +1,151 @@
+package jsonschema
+
+import (
+	"regexp"
+	"regexp/syntax"
+	"strings"
+	"unicode"
+)
+
+var (
+	leadingFlags = regexp.MustCompile(`^\(\?([a-zA-Z]+)\)`)
+	repeat       = regexp.MustCompile(`^\{\d+(,\d*)?\}`)
+)
+
+// ECMAScriptPattern translates pattern, a Go regular expression, to the
+// source and flags of an ECMAScript regular expression in unicode mode, as
+// read by JSON Schema "pattern" and JavaScript's RegExp. Flags set at the
+// start of pattern, like (?i), become flags; (?P<name> becomes (?<name>, \A
+// and \z become ^ and $, \pL becomes \p{L}, and escapes or braces rejected in
+// unicode mode are rewritten.
+//
+// ok is false for invalid patterns and for syntax without an ECMAScript
+// equivalent: flags set or cleared after the start, (?U), \Q...\E, \C, octal
+// escapes and POSIX classes like [[:alpha:]].
+func ECMAScriptPattern(pattern string) (source, flags string, ok bool) {
+	if _, err := syntax.Parse(pattern, syntax.Perl); err != nil {
+		return "", "", false
+	}
+
+	rest := pattern
+	for m := leadingFlags.FindStringSubmatch(rest); m != nil; m = leadingFlags.FindStringSubmatch(rest) {
+		for _, f := range m[1] {
+			if !strings.ContainsRune("ims", f) {
+				return "", "", false
+			}
+			if !strings.ContainsRune(flags, f) {
+				flags += string(f)
+			}
+		}
+		rest = rest[len(m[0]):]
+	}
+
+	var b strings.Builder
+	inClass := false
+	for i := 0; i < len(rest); i++ {
+		c := rest[i]
+		switch {
+		case c == '\\':
+			i++
+			n, ok := escape(&b, rest[i:], inClass)
+			if !ok {
+				return "", "", false
+			}
+			i += n
+		case inClass && c == '[':
+			if strings.HasPrefix(rest[i:], "[:") {
+				return "", "", false
+			}
+			b.WriteString(`\[`)
+		case inClass && c == ']':
+			inClass = false
+			b.WriteByte(c)
+		case c == '[':
+			inClass = true
+			b.WriteByte(c)
+			if strings.HasPrefix(rest[i+1:], "^") {
+				b.WriteByte('^')
+				i++
+			}
+			// A ] right after [ or [^ is a literal in RE2 and closes an empty
+			// class in ECMAScript.
+			if strings.HasPrefix(rest[i+1:], "]") {
+				b.WriteString(`\]`)
+				i++
+			}
+		case c == '(' && strings.HasPrefix(rest[i:], "(?"):
+			switch {
+			case strings.HasPrefix(rest[i:], "(?P<"):
+				b.WriteString("(?<")
+				i += 3
+			case strings.HasPrefix(rest[i:], "(?:"), strings.HasPrefix(rest[i:], "(?<"):
+				b.WriteString("(?")
+				i++
+			default:
+				return "", "", false
+			}
+		case c == '{' || c == '}':
+			if m := repeat.FindString(rest[i:]); c == '{' && m != "" {
+				b.WriteString(m)
+				i += len(m) - 1
+			} else {
+				b.WriteString(`\` + string(c))
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String(), flags, true
+}
+
+// escape writes the translation of the escape sequence whose backslash
+// precedes s, and returns the number of bytes of s it consumed after the
+// first one.
+func escape(b *strings.Builder, s string, inClass bool) (int, bool) {
+	c := s[0]
+	switch {
+	case c == 'A' && !inClass:
+		b.WriteByte('^')
+	case c == 'z' && !inClass:
+		b.WriteByte('$')
+	case c == 'a':
+		b.WriteString(`\x07`)
+	case c == 'p' || c == 'P':
+		name, n := s[1:2], 1
+		if name == "{" {
+			end := strings.IndexByte(s, '}')
+			name, n = s[2:end], end
+		}
+		if strings.HasPrefix(name, "^") {
+			name = name[1:]
+			c ^= 'p' ^ 'P'
+		}
+		switch {
+		case name == "Any" || unicode.Categories[name] != nil:
+		case unicode.Scripts[name] != nil:
+			name = "Script=" + name
+		default:
+			return 0, false
+		}
+		b.WriteString(`\` + string(c) + "{" + name + "}")
+		return n, true
+	case c == 'x' && strings.HasPrefix(s, "x{"):
+		end := strings.IndexByte(s, '}')
+		b.WriteString(`\u` + s[1:end+1])
+		return end, true
+	case strings.IndexByte("bBdDfnrsStvwWx", c) >= 0:
+		b.WriteString(`\` + string(c))
+	case strings.IndexByte(`^$\.*+?()[]{}|/`, c) >= 0, inClass && c == '-':
+		b.WriteString(`\` + string(c))
+	case c < 0x80 && !isAlnum(c):
+		// Unicode mode rejects escaped punctuation that is not syntax.
+		b.WriteByte(c)
+	default:
+		return 0, false
+	}
+	return 0, true
+}
+
+func isAlnum(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9'
+}
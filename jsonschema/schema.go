@@ -0,0 +1,151 @@
+// Package jsonschema exports validation rules as JSON Schema 2020-12, and
+// validates dynamic documents against JSON Schemas.
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// Draft202012 is the $schema URI of JSON Schema 2020-12.
+const Draft202012 = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema is a JSON Schema 2020-12 document or subschema. Only the keywords
+// produced by this package are represented.
+type Schema struct {
+	Dialect     string `json:"$schema,omitempty"`
+	ID          string `json:"$id,omitempty"`
+	Ref         string `json:"$ref,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+
+	Type   Types  `json:"type,omitempty"`
+	Format string `json:"format,omitempty"`
+	Enum   []any  `json:"enum,omitempty"`
+	Const  any    `json:"const,omitempty"`
+
+	MinLength *int   `json:"minLength,omitempty"`
+	MaxLength *int   `json:"maxLength,omitempty"`
+	Pattern   string `json:"pattern,omitempty"`
+
+	Minimum          json.Number `json:"minimum,omitempty"`
+	Maximum          json.Number `json:"maximum,omitempty"`
+	ExclusiveMinimum json.Number `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum json.Number `json:"exclusiveMaximum,omitempty"`
+	MultipleOf       json.Number `json:"multipleOf,omitempty"`
+
+	Items       *Schema `json:"items,omitempty"`
+	MinItems    *int    `json:"minItems,omitempty"`
+	MaxItems    *int    `json:"maxItems,omitempty"`
+	UniqueItems bool    `json:"uniqueItems,omitempty"`
+
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	MinProperties        *int               `json:"minProperties,omitempty"`
+	MaxProperties        *int               `json:"maxProperties,omitempty"`
+
+	AllOf []*Schema          `json:"allOf,omitempty"`
+	Defs  map[string]*Schema `json:"$defs,omitempty"`
+
+	// Extensions holds additional keywords, such as OpenAPI "x-" extensions,
+	// written alongside the others.
+	Extensions map[string]any `json:"-"`
+
+	// never marks the boolean schema false.
+	never bool
+}
+
+// False returns the boolean schema false, which no value is valid against
+// (e.g. as AdditionalProperties to reject unknown properties).
+func False() *Schema {
+	return &Schema{never: true}
+}
+
+// MarshalJSON implements json.Marshaler, writing Extensions as keywords.
+func (s Schema) MarshalJSON() ([]byte, error) {
+	if s.never {
+		return []byte("false"), nil
+	}
+	type schema Schema
+	b, err := json.Marshal(schema(s))
+	if err != nil || len(s.Extensions) == 0 {
+		return b, err
+	}
+	ext, err := json.Marshal(s.Extensions)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 2 { // {}
+		return ext, nil
+	}
+	return append(append(b[:len(b)-1], ','), ext[1:]...), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts the boolean schemas
+// true and false, and reads "x-" keywords into Extensions.
+func (s *Schema) UnmarshalJSON(data []byte) error {
+	switch string(bytes.TrimSpace(data)) {
+	case "true":
+		*s = Schema{}
+		return nil
+	case "false":
+		*s = Schema{never: true}
+		return nil
+	}
+	type schema Schema
+	if err := json.Unmarshal(data, (*schema)(s)); err != nil {
+		return err
+	}
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	for k, v := range raw {
+		if !strings.HasPrefix(k, "x-") {
+			continue
+		}
+		var ext any
+		if err := json.Unmarshal(v, &ext); err != nil {
+			return err
+		}
+		if s.Extensions == nil {
+			s.Extensions = map[string]any{}
+		}
+		s.Extensions[k] = ext
+	}
+	return nil
+}
+
+// Types is the value of the "type" keyword. It is written as a string when
+// it holds a single type and as an array otherwise.
+type Types []string
+
+// MarshalJSON implements json.Marshaler.
+func (t Types) MarshalJSON() ([]byte, error) {
+	if len(t) == 1 {
+		return json.Marshal(t[0])
+	}
+	return json.Marshal([]string(t))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *Types) UnmarshalJSON(data []byte) error {
+	var one string
+	if err := json.Unmarshal(data, &one); err == nil {
+		*t = Types{one}
+		return nil
+	}
+	return json.Unmarshal(data, (*[]string)(t))
+}
+
+// Has reports whether t contains typ.
+func (t Types) Has(typ string) bool {
+	for _, v := range t {
+		if v == typ {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,176 @@
+// Package openapi exports validation rules as OpenAPI 3.1 component schemas.
+package openapi
+
+import (
+	"maps"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/alexisvisco/valid"
+	"github.com/alexisvisco/valid/httpvalid"
+	"github.com/alexisvisco/valid/is"
+	"github.com/alexisvisco/valid/jsonschema"
+)
+
+// SchemaPrefix is the prefix of references to component schemas.
+const SchemaPrefix = "#/components/schemas/"
+
+// ResponsePrefix is the prefix of references to component responses.
+const ResponsePrefix = "#/components/responses/"
+
+// Components is the "components" object of an OpenAPI 3.1 document. Marshal
+// it to JSON (or YAML) and merge it into the document.
+type Components struct {
+	Schemas   map[string]*jsonschema.Schema `json:"schemas,omitempty"`
+	Responses map[string]*Response          `json:"responses,omitempty"`
+
+	// types holds the schema names of the types added with Add.
+	types map[reflect.Type]string
+}
+
+// Response is an OpenAPI response object.
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+// Reference is an OpenAPI reference object, e.g. to a component response.
+type Reference struct {
+	Ref string `json:"$ref"`
+}
+
+// MediaType is an OpenAPI media type object.
+type MediaType struct {
+	Schema *jsonschema.Schema `json:"schema,omitempty"`
+}
+
+// NewComponents returns empty Components.
+func NewComponents() *Components {
+	return &Components{Schemas: map[string]*jsonschema.Schema{}, Responses: map[string]*Response{}}
+}
+
+// Add adds the schema of T to c, named after T, and returns a reference to it.
+// The schema is derived from the Valid method of T like jsonschema.For:
+//   - properties validated by is.Required are listed in "required";
+//   - ishelper.Optional fields have "null" among their types, the OpenAPI 3.1
+//     form of nullable;
+//   - every field with rules has an "x-error-codes" extension listing the
+//     ViolationCodes it can produce.
+//
+// Named types validated with valid.Nested become schemas of their own.
+// Adding a type twice keeps the first schema. A type whose name is already
+// used by another type or schema gets a numeric suffix (e.g. "address2" for
+// an address type of another package).
+func Add[T valid.Validatable](c *Components) *jsonschema.Schema {
+	t := reflect.TypeFor[T]()
+	var v any
+	if t.Kind() == reflect.Pointer {
+		v = reflect.New(t.Elem()).Interface()
+	} else {
+		var zero T
+		v = zero
+	}
+	name := c.name(t)
+	if _, ok := c.Schemas[name]; ok {
+		return &jsonschema.Schema{Ref: SchemaPrefix + name}
+	}
+	s := jsonschema.GenerateWith(t, valid.Describe(valid.Nested("", v)), jsonschema.Options{ErrorCodes: true, Nullable: true, DefName: c.name})
+	defs := s.Defs
+	s.Defs = nil
+	c.add(name, s, name)
+	for defName, def := range defs {
+		c.add(defName, def, name)
+	}
+	return &jsonschema.Schema{Ref: SchemaPrefix + name}
+}
+
+// ErrorResponse adds the schemas of the JSON body written by
+// httpvalid.WriteError for a *valid.Error to c, with a "ValidationError"
+// response, and returns a reference to the response. The schemas are named
+// after valid.Error and valid.FieldError like the types added with Add.
+func ErrorResponse(c *Components) *Reference {
+	fieldError := c.name(reflect.TypeFor[valid.FieldError]())
+	c.add(fieldError, &jsonschema.Schema{
+		Type: jsonschema.Types{"object"},
+		Properties: map[string]*jsonschema.Schema{
+			"path":    {Type: jsonschema.Types{"string"}, Description: "Path of the invalid field, e.g. items.0.sku."},
+			"code":    {Type: jsonschema.Types{"string"}, Description: "Violation code, e.g. " + string(is.ViolationRequired) + "."},
+			"message": {Type: jsonschema.Types{"string"}},
+			"params":  {Type: jsonschema.Types{"object"}, Description: "Parameters of the violation, e.g. min and max."},
+		},
+		Required: []string{"path", "code", "message"},
+	}, fieldError)
+	name := c.name(reflect.TypeFor[valid.Error]())
+	c.add(name, &jsonschema.Schema{
+		Type: jsonschema.Types{"object"},
+		Properties: map[string]*jsonschema.Schema{
+			"code":    {Type: jsonschema.Types{"string"}, Enum: []any{httpvalid.CodeValidationFailed, httpvalid.CodeInternalError}},
+			"message": {Type: jsonschema.Types{"string"}},
+			"fields":  {Type: jsonschema.Types{"array"}, Items: &jsonschema.Schema{Ref: SchemaPrefix + fieldError}},
+		},
+		Required: []string{"code", "message"},
+	}, name)
+	c.Responses["ValidationError"] = &Response{
+		Description: "The request is invalid.",
+		Content: map[string]MediaType{
+			"application/json": {Schema: &jsonschema.Schema{Ref: SchemaPrefix + name}},
+		},
+	}
+	return &Reference{Ref: ResponsePrefix + "ValidationError"}
+}
+
+// add adds s under name, rewriting its references to $defs and to the root
+// schema (named root) to component references.
+func (c *Components) add(name string, s *jsonschema.Schema, root string) {
+	if _, ok := c.Schemas[name]; ok {
+		return
+	}
+	rewriteRefs(s, root)
+	c.Schemas[name] = s
+}
+
+func rewriteRefs(s *jsonschema.Schema, root string) {
+	if s == nil {
+		return
+	}
+	if s.Ref == "#" {
+		s.Ref = SchemaPrefix + root
+	} else if name, ok := strings.CutPrefix(s.Ref, "#/$defs/"); ok {
+		s.Ref = SchemaPrefix + name
+	}
+	rewriteRefs(s.Items, root)
+	rewriteRefs(s.AdditionalProperties, root)
+	for _, p := range s.Properties {
+		rewriteRefs(p, root)
+	}
+	for _, sub := range s.AllOf {
+		rewriteRefs(sub, root)
+	}
+}
+
+// name returns the schema name of t: the name of the type, with a numeric
+// suffix when another type or schema already uses it.
+func (c *Components) name(t reflect.Type) string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if name, ok := c.types[t]; ok {
+		return name
+	}
+	if c.types == nil {
+		c.types = map[reflect.Type]string{}
+	}
+	base := invalidNameChars.ReplaceAllString(t.Name(), "_")
+	name := base
+	for i := 2; c.Schemas[name] != nil || slices.Contains(slices.Collect(maps.Values(c.types)), name); i++ {
+		name = base + strconv.Itoa(i)
+	}
+	c.types[t] = name
+	return name
+}
+
+// invalidNameChars matches the characters not allowed in component names.
+var invalidNameChars = regexp.MustCompile(`[^A-Za-z0-9_.-]+`)
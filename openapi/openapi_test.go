@@ -0,0 +1,137 @@
+package openapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alexisvisco/valid"
+	"github.com/alexisvisco/valid/httpvalid"
+	"github.com/alexisvisco/valid/is"
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/alexisvisco/valid/openapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type address struct {
+	City string
+}
+
+func (a address) Valid(ctx context.Context) error {
+	return valid.Struct(ctx, valid.Field("city", a.City, is.Required, is.MaxLength(80)))
+}
+
+type createUser struct {
+	Email    string
+	Nickname ishelper.Optional
+	Home     address
+	Manager  *createUser
+}
+
+func (u createUser) Valid(ctx context.Context) error {
+	return valid.Struct(ctx,
+		valid.Field("email", u.Email, is.Required, is.Email),
+		valid.Field("nickname", ishelper.None[string](), is.Normalized(nil, is.MinLength(2))),
+		valid.Nested("home", u.Home),
+		valid.Nested("manager", u.Manager),
+	)
+}
+
+func TestAdd(t *testing.T) {
+	t.Parallel()
+
+	c := openapi.NewComponents()
+	ref := openapi.Add[createUser](c)
+	assert.Equal(t, "#/components/schemas/createUser", ref.Ref)
+
+	got, err := json.Marshal(c.Schemas)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"createUser": {
+			"type": "object",
+			"properties": {
+				"email": {"type": "string", "minLength": 1, "format": "email", "x-error-codes": ["VALIDATION_REQUIRED", "VALIDATION_EMAIL"]},
+				"nickname": {"type": ["string", "null"], "x-error-codes": ["VALIDATION_MIN_LENGTH"]},
+				"home": {"$ref": "#/components/schemas/address"},
+				"manager": {"$ref": "#/components/schemas/createUser"}
+			},
+			"required": ["email"]
+		},
+		"address": {
+			"type": "object",
+			"properties": {
+				"city": {"type": "string", "minLength": 1, "maxLength": 80, "x-error-codes": ["VALIDATION_REQUIRED", "VALIDATION_MAX_LENGTH"]}
+			},
+			"required": ["city"]
+		}
+	}`, string(got))
+}
+
+type box[T any] struct {
+	Label string
+}
+
+func (b box[T]) Valid(ctx context.Context) error {
+	return valid.Struct(ctx, valid.Field("label", b.Label, is.Required))
+}
+
+func TestAddNameCollision(t *testing.T) {
+	t.Parallel()
+
+	// Both names sanitize to "box_int_".
+	c := openapi.NewComponents()
+	assert.Equal(t, "#/components/schemas/box_int_", openapi.Add[box[[]int]](c).Ref)
+	assert.Equal(t, "#/components/schemas/box_int_2", openapi.Add[box[*int]](c).Ref)
+	assert.Equal(t, "#/components/schemas/box_int_", openapi.Add[*box[[]int]](c).Ref)
+	assert.Len(t, c.Schemas, 2)
+}
+
+func TestErrorResponse(t *testing.T) {
+	t.Parallel()
+
+	c := openapi.NewComponents()
+	ref := openapi.ErrorResponse(c)
+	assert.Equal(t, "#/components/responses/ValidationError", ref.Ref)
+	require.Contains(t, c.Responses, "ValidationError")
+	assert.Equal(t, "#/components/schemas/Error", c.Responses["ValidationError"].Content["application/json"].Schema.Ref)
+
+	// The documented properties are the ones written by httpvalid.WriteError.
+	rec := httptest.NewRecorder()
+	httpvalid.WriteError(rec, &valid.Error{Fields: []valid.FieldError{{Path: "email", Code: string(is.ViolationEmail), Message: "must be a valid email", Params: map[string]any{"x": 1}}}})
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	for k := range body {
+		assert.Contains(t, c.Schemas["Error"].Properties, k)
+	}
+	for k := range body["fields"].([]any)[0].(map[string]any) {
+		assert.Contains(t, c.Schemas["FieldError"].Properties, k)
+	}
+}
+
+// Error is a user type named like the schema of the error body.
+type Error struct {
+	Reason string
+}
+
+func (e Error) Valid(ctx context.Context) error {
+	return valid.Struct(ctx, valid.Field("reason", e.Reason, is.Required))
+}
+
+func TestErrorResponseNameCollision(t *testing.T) {
+	t.Parallel()
+
+	c := openapi.NewComponents()
+	assert.Equal(t, "#/components/schemas/Error", openapi.Add[Error](c).Ref)
+	openapi.ErrorResponse(c)
+	openapi.ErrorResponse(c)
+	assert.Contains(t, c.Schemas["Error"].Properties, "reason")
+	assert.Equal(t, "#/components/schemas/Error2", c.Responses["ValidationError"].Content["application/json"].Schema.Ref)
+	assert.Contains(t, c.Schemas["Error2"].Properties, "fields")
+	assert.Len(t, c.Schemas, 3)
+
+	b, err := json.Marshal(openapi.ErrorResponse(c))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"$ref": "#/components/responses/ValidationError"}`, string(b))
+}
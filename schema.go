@@ -0,0 +1,189 @@
+package valid
+
+import (
+	"context"
+	"reflect"
+	"slices"
+
+	"github.com/alexisvisco/valid/is"
+	"github.com/alexisvisco/valid/ishelper"
+)
+
+// Schema is a reusable set of validated properties of a T. Build it with
+// NewSchema from Prop and PropSchema, and derive others with Extend, Pick,
+// Omit and Partial; a Schema is never modified once built.
+type Schema[T any] struct {
+	props []Property[T]
+}
+
+// Property is a validated property of a T, created by Prop or PropSchema.
+type Property[T any] struct {
+	path string
+	// group returns the FieldGroup validating the property of v. v is nil in
+	// describe mode.
+	group    func(v *T, optional bool) FieldGroup
+	optional bool
+}
+
+// Path returns the path of p, as reported in FieldErrors.
+func (p Property[T]) Path() string {
+	return p.path
+}
+
+// NewSchema returns a Schema validating props in order. Several properties
+// may share a path, like several groups given to Struct.
+func NewSchema[T any](props ...Property[T]) *Schema[T] {
+	return &Schema[T]{props: slices.Clone(props)}
+}
+
+// Prop returns a Property validating the value returned by get against rules,
+// like Field.
+func Prop[T, V any](path string, get func(v *T) V, rules ...is.Rule) Property[T] {
+	typ := reflect.TypeFor[V]()
+	group := func(v *T, optional bool) FieldGroup {
+		return func(ctx context.Context) []FieldError {
+			if d := describing(ctx); d != nil {
+				specs := is.DescribeRules(rules)
+				if optional {
+					specs = slices.DeleteFunc(specs, func(s is.RuleSpec) bool { return s.Code == is.ViolationRequired })
+				}
+				d.add(FieldSpec{Path: path, Type: typ, Rules: specs})
+				return nil
+			}
+			if v == nil {
+				return nil
+			}
+			value := any(get(v))
+			if optional && absent(value) {
+				return nil
+			}
+			return Field(path, value, rules...)(ctx)
+		}
+	}
+	return Property[T]{path: path, group: group}
+}
+
+// PropSchema returns a Property validating the value returned by get against
+// schema, like Nested: field errors are prefixed with path, and a nil value
+// produces no errors.
+func PropSchema[T, V any](path string, get func(v *T) *V, schema *Schema[V]) Property[T] {
+	group := func(v *T, _ bool) FieldGroup {
+		var value *V
+		if v != nil {
+			value = get(v)
+		}
+		return Nested(path, schema.Bind(value))
+	}
+	return Property[T]{path: path, group: group}
+}
+
+// absent reports whether value is None or the zero value of its type.
+func absent(value any) bool {
+	if opt, ok := value.(ishelper.Optional); ok {
+		return opt.IsNone()
+	}
+	return value == nil || reflect.ValueOf(value).IsZero()
+}
+
+// Props returns the properties of s, e.g. to extend another Schema with them.
+func (s *Schema[T]) Props() []Property[T] {
+	return slices.Clone(s.props)
+}
+
+// Extend returns a Schema with the properties of s followed by props.
+// Properties of s sharing a path with one of props are replaced.
+func (s *Schema[T]) Extend(props ...Property[T]) *Schema[T] {
+	kept := slices.DeleteFunc(slices.Clone(s.props), func(p Property[T]) bool {
+		return slices.ContainsFunc(props, func(q Property[T]) bool { return q.path == p.path })
+	})
+	return &Schema[T]{props: append(kept, props...)}
+}
+
+// Pick returns a Schema with the properties of s at paths only. Unknown paths
+// panic.
+func (s *Schema[T]) Pick(paths ...string) *Schema[T] {
+	s.mustHave("Pick", paths)
+	return &Schema[T]{props: slices.DeleteFunc(slices.Clone(s.props), func(p Property[T]) bool {
+		return !slices.Contains(paths, p.path)
+	})}
+}
+
+// Omit returns a Schema without the properties of s at paths. Unknown paths
+// panic.
+func (s *Schema[T]) Omit(paths ...string) *Schema[T] {
+	s.mustHave("Omit", paths)
+	return &Schema[T]{props: slices.DeleteFunc(slices.Clone(s.props), func(p Property[T]) bool {
+		return slices.Contains(paths, p.path)
+	})}
+}
+
+// Partial returns a Schema whose properties are optional: the rules of a Prop
+// are skipped when its value is None or the zero value of its type, so
+// is.Required never fails. Properties of a PropSchema are not made optional.
+func (s *Schema[T]) Partial() *Schema[T] {
+	props := slices.Clone(s.props)
+	for i := range props {
+		props[i].optional = true
+	}
+	return &Schema[T]{props: props}
+}
+
+func (s *Schema[T]) mustHave(method string, paths []string) {
+	for _, path := range paths {
+		if !slices.ContainsFunc(s.props, func(p Property[T]) bool { return p.path == path }) {
+			panic("valid.Schema." + method + ": unknown path " + path)
+		}
+	}
+}
+
+// Group returns a FieldGroup validating the properties of v, to combine s
+// with other groups in Struct. A nil v produces no errors.
+func (s *Schema[T]) Group(v *T) FieldGroup {
+	return func(ctx context.Context) []FieldError {
+		var errs []FieldError
+		for _, p := range s.props {
+			errs = append(errs, p.group(v, p.optional)(ctx)...)
+		}
+		return errs
+	}
+}
+
+// Validate validates v against s like Struct. A nil v is valid.
+func (s *Schema[T]) Validate(ctx context.Context, v *T) error {
+	return Struct(ctx, s.Group(v))
+}
+
+// Describe returns the fields validated by s and their rules, like the
+// Describe function, e.g. to generate a JSON Schema with
+// jsonschema.Generate(reflect.TypeFor[T](), s.Describe()).
+func (s *Schema[T]) Describe() []FieldSpec {
+	return Describe(s.Group(nil))
+}
+
+// Bind returns a Validatable validating v against s, to use s where a
+// Validatable is expected (e.g. Nested, httpvalid).
+func (s *Schema[T]) Bind(v *T) Validatable {
+	return bound[T]{schema: s, v: v}
+}
+
+// bound is a value bound to its Schema.
+type bound[T any] struct {
+	schema *Schema[T]
+	v      *T
+}
+
+func (b bound[T]) Valid(ctx context.Context) error {
+	return b.schema.Validate(ctx, b.v)
+}
+
+// boundType implements binding.
+func (b bound[T]) boundType() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+// binding is implemented by the values returned by Schema.Bind, which
+// Describe describes through their Schema instead of a zero value.
+type binding interface {
+	Validatable
+	boundType() reflect.Type
+}
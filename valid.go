@@ -5,8 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
 	"github.com/alexisvisco/valid/is"
+	"github.com/alexisvisco/valid/ishelper"
 )
 
 // FieldError represents a single field validation error.
@@ -14,6 +16,8 @@ type FieldError struct {
 	Path    string
 	Code    string
 	Message string
+	// Params carries the violation params reported by the rule, if any.
+	Params map[string]any
 }
 
 // Error is a collection of FieldErrors returned by Struct.
@@ -46,6 +50,7 @@ func (e *Error) Rename(mapping map[string]string) *Error {
 			Path:    target,
 			Code:    fe.Code,
 			Message: fe.Message,
+			Params:  fe.Params,
 		})
 	}
 	if len(fields) == 0 {
@@ -68,12 +73,17 @@ type Validatable interface {
 // called by Struct. Rules are short-circuited: the first violation stops evaluation.
 func Field(path string, value any, rules ...is.Rule) FieldGroup {
 	return func(ctx context.Context) []FieldError {
+		if d := describing(ctx); d != nil {
+			d.add(FieldSpec{Path: path, Type: reflect.TypeOf(value), Rules: is.DescribeRules(rules)})
+			return nil
+		}
 		for _, rule := range rules {
 			if v := rule(ctx, value); v != nil {
 				return []FieldError{{
 					Path:    path,
 					Code:    string(v.Code),
 					Message: v.Message,
+					Params:  v.Params,
 				}}
 			}
 		}
@@ -81,6 +91,90 @@ func Field(path string, value any, rules ...is.Rule) FieldGroup {
 	}
 }
 
+// PasswordField returns a FieldGroup that checks value against policy like
+// is.Password, but reports every unmet requirement as its own FieldError, with
+// the code and params of is.PasswordPolicy.Violations. Each one is reported at
+// a child of path named after its code, e.g. "Password.too_short" for
+// is.ViolationPasswordTooShort, so that Struct keeps them all and skips them
+// after an error at path.
+func PasswordField(path string, value any, policy is.PasswordPolicy) FieldGroup {
+	rule := is.Password(policy)
+	return func(ctx context.Context) []FieldError {
+		if d := describing(ctx); d != nil {
+			d.add(FieldSpec{Path: path, Type: reflect.TypeOf(value), Rules: is.DescribeRules([]is.Rule{rule})})
+			return nil
+		}
+		resolved, skip := ishelper.ExtractOptional(value)
+		if skip {
+			return nil
+		}
+		s, ok := resolved.(string)
+		if !ok {
+			return Field(path, resolved, rule)(ctx)
+		}
+		var errs []FieldError
+		for _, v := range policy.Violations(ctx, s) {
+			requirement := strings.ToLower(strings.TrimPrefix(string(v.Code), string(is.ViolationPassword)+"_"))
+			errs = append(errs, FieldError{Path: path + "." + requirement, Code: string(v.Code), Message: v.Message, Params: v.Params})
+		}
+		return errs
+	}
+}
+
+// Clean applies sanitizers to *ptr in order and stores the result, then returns
+// a FieldGroup that reports no errors. Sanitizing happens as soon as Clean is
+// called rather than when Struct runs, so listing it in Struct before the
+// Field groups of the same variables makes them validate the stored, cleaned
+// values. A nil ptr is ignored.
+func Clean[T any](ptr *T, sanitizers ...func(T) T) FieldGroup {
+	if ptr != nil {
+		for _, fn := range sanitizers {
+			*ptr = fn(*ptr)
+		}
+	}
+	return func(context.Context) []FieldError { return nil }
+}
+
+// Parse returns a FieldGroup that converts raw with parser when called by
+// Struct, evaluates rules against the parsed value and, if all pass, stores it
+// in *dst. Parse failures and rule violations are reported at path like Field
+// errors. A None raw value is skipped and leaves *dst untouched; Some is
+// unwrapped before parsing.
+func Parse[T any](dst *T, path string, raw any, parser is.Parser[T], rules ...is.Rule) FieldGroup {
+	return func(ctx context.Context) []FieldError {
+		if d := describing(ctx); d != nil {
+			d.add(FieldSpec{Path: path, Type: reflect.TypeOf(raw), Rules: []is.RuleSpec{{
+				Name:   "Parse",
+				Params: map[string]any{"type": reflect.TypeFor[T]()},
+				Rules:  is.DescribeRules(rules),
+			}}})
+			return nil
+		}
+		resolved, skip := ishelper.ExtractOptional(raw)
+		if skip {
+			return nil
+		}
+		parsed, v := parser(ctx, resolved)
+		if v == nil {
+			for _, rule := range rules {
+				if v = rule(ctx, parsed); v != nil {
+					break
+				}
+			}
+		}
+		if v != nil {
+			return []FieldError{{
+				Path:    path,
+				Code:    string(v.Code),
+				Message: v.Message,
+				Params:  v.Params,
+			}}
+		}
+		*dst = parsed
+		return nil
+	}
+}
+
 // Struct evaluates all groups with ctx and aggregates their FieldErrors into a
 // single *Error. Returns nil if no errors are found.
 //
@@ -125,6 +219,14 @@ func Nested(path string, v any) FieldGroup {
 		if v == nil {
 			return nil
 		}
+		if d := describing(ctx); d != nil {
+			if b, ok := v.(binding); ok {
+				d.add(d.bound(ctx, path, b))
+				return nil
+			}
+			d.add(d.nested(ctx, path, reflect.TypeOf(v)))
+			return nil
+		}
 		rv := reflect.ValueOf(v)
 		if rv.Kind() == reflect.Ptr {
 			if rv.IsNil() {
@@ -167,6 +269,7 @@ func nestedOne(path string, v any) FieldGroup {
 					Path:    path + "." + fe.Path,
 					Code:    fe.Code,
 					Message: fe.Message,
+					Params:  fe.Params,
 				}
 			}
 			return fields
@@ -180,6 +283,21 @@ func nestedOne(path string, v any) FieldGroup {
 // prefixed as "path.i.*" in the result.
 func Slice[T any](path string, items []T, fn func(ctx context.Context, i int, item T) error) FieldGroup {
 	return func(ctx context.Context) []FieldError {
+		if d := describing(ctx); d != nil {
+			elem := element(reflect.TypeOf(items))
+			base := elem.Type
+			if base.Kind() == reflect.Pointer {
+				base = base.Elem()
+			}
+			if !slices.Contains(d.types, base) {
+				elem.Fields = d.collect(ctx, base, func(ctx context.Context) {
+					var zero T
+					_ = fn(ctx, 0, zero)
+				})
+			}
+			d.add(FieldSpec{Path: path, Type: reflect.TypeOf(items), Fields: []FieldSpec{elem}})
+			return nil
+		}
 		var errs []FieldError
 		for i, item := range items {
 			err := fn(ctx, i, item)
@@ -192,6 +310,7 @@ func Slice[T any](path string, items []T, fn func(ctx context.Context, i int, it
 						Path:    fmt.Sprintf("%s.%d.%s", path, i, fe.Path),
 						Code:    fe.Code,
 						Message: fe.Message,
+						Params:  fe.Params,
 					})
 				}
 			} else {
@@ -209,6 +328,11 @@ func Slice[T any](path string, items []T, fn func(ctx context.Context, i int, it
 // Rules are short-circuited per element. Violations are reported as "path.i".
 func Each[T any](path string, items []T, rules ...is.Rule) FieldGroup {
 	return func(ctx context.Context) []FieldError {
+		if d := describing(ctx); d != nil {
+			t := reflect.TypeOf(items)
+			d.add(FieldSpec{Path: path, Type: t, Fields: []FieldSpec{element(t, is.DescribeRules(rules)...)}})
+			return nil
+		}
 		var errs []FieldError
 		for i, item := range items {
 			for _, rule := range rules {
@@ -217,6 +341,7 @@ func Each[T any](path string, items []T, rules ...is.Rule) FieldGroup {
 						Path:    fmt.Sprintf("%s.%d", path, i),
 						Code:    string(v.Code),
 						Message: v.Message,
+						Params:  v.Params,
 					})
 					break
 				}
@@ -226,6 +351,47 @@ func Each[T any](path string, items []T, rules ...is.Rule) FieldGroup {
 	}
 }
 
+// UniqueBy reports every element of items whose key was already seen in an
+// earlier element and returns a FieldGroup. Violations are reported as
+// "path.i.field" (or "path.i" when field is empty) with code
+// is.ViolationUnique and the index of the first occurrence in Params["first"].
+func UniqueBy[T any, K comparable](path string, items []T, field string, key func(T) K) FieldGroup {
+	return func(ctx context.Context) []FieldError {
+		if d := describing(ctx); d != nil {
+			t := reflect.TypeOf(items)
+			unique := is.RuleSpec{Name: "UniqueBy", Code: is.ViolationUnique}
+			elem := element(t, unique)
+			if field != "" {
+				elem = element(t)
+				elem.Fields = []FieldSpec{{Path: field, Rules: []is.RuleSpec{unique}}}
+			}
+			d.add(FieldSpec{Path: path, Type: t, Fields: []FieldSpec{elem}})
+			return nil
+		}
+		var errs []FieldError
+		first := make(map[K]int, len(items))
+		for i, item := range items {
+			k := key(item)
+			j, seen := first[k]
+			if !seen {
+				first[k] = i
+				continue
+			}
+			itemPath := fmt.Sprintf("%s.%d", path, i)
+			if field != "" {
+				itemPath += "." + field
+			}
+			errs = append(errs, FieldError{
+				Path:    itemPath,
+				Code:    string(is.ViolationUnique),
+				Message: is.FormatMessage(is.ViolationUnique, nil),
+				Params:  map[string]any{"first": j},
+			})
+		}
+		return errs
+	}
+}
+
 // As returns *Error if err is (or wraps) a *Error. Returns nil otherwise.
 func As(err error) *Error {
 	var ve *Error
@@ -6,7 +6,11 @@ import (
 	"fmt"
 	"github.com/alexisvisco/valid"
 	"github.com/alexisvisco/valid/is"
+	"github.com/alexisvisco/valid/ishelper"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/goforj/godump"
 
@@ -116,6 +120,19 @@ func TestStruct(t *testing.T) {
 		require.Equal(t, "B", ve.Fields[1].Path)
 	})
 
+	t.Run("violation params → FieldError.Params", func(t *testing.T) {
+		t.Parallel()
+		err := valid.Struct(context.Background(),
+			valid.Field("Name", "Jean-Luc", is.Letters),
+			valid.Nested("Payment", &PaymentParams{Method: "card", TransactionID: "txn_1"}),
+		)
+		ve := valid.As(err)
+		require.NotNil(t, ve)
+		require.Len(t, ve.Fields, 1)
+		assert.Equal(t, map[string]any{"char": "-", "position": 4}, ve.Fields[0].Params)
+		assert.Equal(t, ve.Fields[0].Params, ve.Rename(map[string]string{"Name": "name"}).Fields[0].Params)
+	})
+
 	t.Run("Error() message format", func(t *testing.T) {
 		t.Parallel()
 		err := valid.Struct(context.Background(), valid.Field("Name", "", is.Required))
@@ -227,6 +244,423 @@ func TestEach(t *testing.T) {
 	})
 }
 
+// ---- UniqueBy ---------------------------------------------------------------
+
+func TestUniqueBy(t *testing.T) {
+	t.Parallel()
+
+	type item struct{ SKU string }
+	sku := func(it item) string { return it.SKU }
+
+	t.Run("nil slice → nil", func(t *testing.T) {
+		t.Parallel()
+		got := valid.UniqueBy("Items", []item(nil), "SKU", sku)(context.Background())
+		require.Nil(t, got)
+	})
+
+	t.Run("unique keys → nil", func(t *testing.T) {
+		t.Parallel()
+		items := []item{{"a"}, {"b"}, {"c"}}
+		got := valid.UniqueBy("Items", items, "SKU", sku)(context.Background())
+		require.Nil(t, got)
+	})
+
+	t.Run("each duplicate reported at path.i.field", func(t *testing.T) {
+		t.Parallel()
+		items := []item{{"a"}, {"b"}, {"a"}, {"a"}}
+		got := valid.UniqueBy("Items", items, "SKU", sku)(context.Background())
+		require.Len(t, got, 2)
+		assert.Equal(t, "Items.2.SKU", got[0].Path)
+		assert.Equal(t, "Items.3.SKU", got[1].Path)
+		assert.Equal(t, string(is.ViolationUnique), got[0].Code)
+		assert.Equal(t, map[string]any{"first": 0}, got[1].Params)
+	})
+
+	t.Run("empty field → path.i", func(t *testing.T) {
+		t.Parallel()
+		got := valid.UniqueBy("Tags", []string{"x", "x"}, "", func(s string) string { return s })(context.Background())
+		require.Len(t, got, 1)
+		assert.Equal(t, "Tags.1", got[0].Path)
+	})
+}
+
+// ---- PasswordField ----------------------------------------------------------
+
+func TestPasswordField(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	policy := is.PasswordPolicy{MinLength: 8, RequireDigit: true, RequireUpper: true}
+
+	err := valid.Struct(ctx,
+		valid.Field("password", "", is.Required),
+		valid.PasswordField("password", "", policy),
+	)
+	assert.Equal(t, []string{string(is.ViolationRequired)}, []string{valid.As(err).Fields[0].Code}, "later groups are deduplicated")
+	assert.Len(t, valid.As(err).Fields, 1)
+
+	err = valid.Struct(ctx, valid.PasswordField("password", "abc", policy))
+	ve := valid.As(err)
+	require.NotNil(t, ve)
+	assert.Equal(t, []valid.FieldError{
+		{Path: "password.too_short", Code: string(is.ViolationPasswordTooShort), Message: "must be at least 8 characters long", Params: map[string]any{"min": 8}},
+		{Path: "password.uppercase", Code: string(is.ViolationPasswordUpper), Message: "must contain an uppercase letter"},
+		{Path: "password.digit", Code: string(is.ViolationPasswordDigit), Message: "must contain a digit"},
+	}, ve.Fields)
+
+	require.NoError(t, valid.Struct(ctx, valid.PasswordField("password", ishelper.None[string](), policy)))
+	assert.Equal(t, string(is.ViolationPassword), valid.As(valid.Struct(ctx, valid.PasswordField("password", 1, policy))).Fields[0].Code)
+	assert.Equal(t, "Password", valid.Describe(valid.PasswordField("password", "", policy))[0].Rules[0].Name)
+}
+
+// ---- Clean ------------------------------------------------------------------
+
+func TestClean(t *testing.T) {
+	t.Parallel()
+
+	t.Run("sanitizers applied in order and stored", func(t *testing.T) {
+		t.Parallel()
+		email := "  John@Example.COM "
+		got := valid.Clean(&email, strings.TrimSpace, strings.ToLower)(context.Background())
+		require.Nil(t, got)
+		assert.Equal(t, "john@example.com", email)
+	})
+
+	t.Run("nil pointer → no-op", func(t *testing.T) {
+		t.Parallel()
+		var p *string
+		require.Nil(t, valid.Clean(p, strings.TrimSpace)(context.Background()))
+	})
+
+	t.Run("fields after Clean validate the cleaned value", func(t *testing.T) {
+		t.Parallel()
+		in := struct{ Email, Method string }{Email: " a@b.co ", Method: " Card"}
+		err := valid.Struct(context.Background(),
+			valid.Clean(&in.Email, strings.TrimSpace),
+			valid.Clean(&in.Method, strings.TrimSpace, strings.ToLower),
+			valid.Field("Email", in.Email, is.Email),
+			valid.Field("Method", in.Method, is.OneOf("card")),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, "a@b.co", in.Email)
+		assert.Equal(t, "card", in.Method)
+	})
+}
+
+// ---- Parse ------------------------------------------------------------------
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success → value stored", func(t *testing.T) {
+		t.Parallel()
+		var port int64
+		got := valid.Parse(&port, "port", "8080", is.IntParser, is.Between(1, 65535))(context.Background())
+		require.Nil(t, got)
+		assert.Equal(t, int64(8080), port)
+	})
+
+	t.Run("parse failure → FieldError, dst untouched", func(t *testing.T) {
+		t.Parallel()
+		port := int64(1)
+		got := valid.Parse(&port, "port", "http", is.IntParser)(context.Background())
+		require.Len(t, got, 1)
+		assert.Equal(t, "port", got[0].Path)
+		assert.Equal(t, string(is.ViolationInteger), got[0].Code)
+		assert.Equal(t, int64(1), port)
+	})
+
+	t.Run("rule failure on parsed value → FieldError, dst untouched", func(t *testing.T) {
+		t.Parallel()
+		var port int64
+		got := valid.Parse(&port, "port", "70000", is.IntParser, is.Between(1, 65535))(context.Background())
+		require.Len(t, got, 1)
+		assert.Equal(t, string(is.ViolationBetween), got[0].Code)
+		assert.Zero(t, port)
+	})
+
+	t.Run("None → skipped", func(t *testing.T) {
+		t.Parallel()
+		var port int64
+		got := valid.Parse(&port, "port", ishelper.None[string](), is.IntParser)(context.Background())
+		require.Nil(t, got)
+	})
+
+	t.Run("aggregated with other groups", func(t *testing.T) {
+		t.Parallel()
+		var out struct {
+			ID    [16]byte
+			Start time.Time
+		}
+		err := valid.Struct(context.Background(),
+			valid.Parse(&out.ID, "id", "6ba7b810-9dad-11d1-80b4-00c04fd430c8", is.UUIDParser),
+			valid.Parse(&out.Start, "start", "yesterday", is.TimeParser(time.RFC3339)),
+			valid.Field("name", "", is.Required),
+		)
+		ve := valid.As(err)
+		require.NotNil(t, ve)
+		require.Len(t, ve.Fields, 2)
+		assert.Equal(t, "start", ve.Fields[0].Path)
+		assert.Equal(t, string(is.ViolationTime), ve.Fields[0].Code)
+		assert.Equal(t, "name", ve.Fields[1].Path)
+		assert.Equal(t, byte(0x6b), out.ID[0])
+	})
+}
+
+// ---- Describe ---------------------------------------------------------------
+
+type describedLine struct {
+	SKU string
+	Qty int
+}
+
+func (l describedLine) Valid(ctx context.Context) error {
+	return valid.Struct(ctx,
+		valid.Field("sku", l.SKU, is.Required, is.MaxLength(12)),
+		valid.Field("qty", l.Qty, is.Between(1, 99)),
+	)
+}
+
+type describedNode struct {
+	Name     string
+	Children []*describedNode
+	Owner    *describedLine
+}
+
+func (n *describedNode) Valid(ctx context.Context) error {
+	return valid.Struct(ctx,
+		valid.Field("name", n.Name, is.Required),
+		valid.Nested("children", n.Children),
+	)
+}
+
+type describedTree struct {
+	Name     string
+	Children []*describedTree
+}
+
+func (n *describedTree) Valid(ctx context.Context) error {
+	return valid.Struct(ctx,
+		valid.Field("name", n.Name, is.Required),
+		valid.Slice("children", n.Children, func(ctx context.Context, _ int, c *describedTree) error {
+			return c.Valid(ctx)
+		}),
+	)
+}
+
+type describedPanic struct {
+	Owner *describedLine
+}
+
+func (p describedPanic) Valid(ctx context.Context) error {
+	return valid.Struct(ctx, valid.Field("owner.sku", p.Owner.SKU, is.Required))
+}
+
+func TestDescribe(t *testing.T) {
+	t.Parallel()
+
+	t.Run("fields, elements and nested types", func(t *testing.T) {
+		t.Parallel()
+		var lines []describedLine
+		var tags []string
+		custom := false
+		specs := valid.Describe(
+			valid.Field("email", "", is.Required, is.Email, alwaysPass()),
+			valid.Field("lines", lines, is.MinItems(1)),
+			valid.Nested("lines", lines),
+			valid.Each("tags", tags, is.OneOf("a", "b")),
+			valid.UniqueBy("lines", lines, "sku", func(l describedLine) string { return l.SKU }),
+			func(context.Context) []valid.FieldError { custom = true; return []valid.FieldError{{Path: "x"}} },
+		)
+		assert.True(t, custom)
+
+		lineType := reflect.TypeFor[describedLine]()
+		assert.Equal(t, []valid.FieldSpec{
+			{Path: "email", Type: reflect.TypeFor[string](), Rules: []is.RuleSpec{
+				{Name: "Required", Code: is.ViolationRequired},
+				{Name: "Email", Code: is.ViolationEmail, Kinds: []is.Kind{is.KindString}},
+			}},
+			{Path: "lines", Type: reflect.TypeFor[[]describedLine](), Rules: []is.RuleSpec{
+				{Name: "MinItems", Code: is.ViolationMinItems, Params: map[string]any{"min": 1}, Kinds: []is.Kind{is.KindList, is.KindMap}},
+			}, Fields: []valid.FieldSpec{{Path: "*", Type: lineType, Fields: []valid.FieldSpec{
+				{Path: "sku", Type: reflect.TypeFor[string](), Rules: []is.RuleSpec{
+					{Name: "Required", Code: is.ViolationRequired},
+					{Name: "MaxLength", Code: is.ViolationMaxLength, Params: map[string]any{"max": 12}, Kinds: []is.Kind{is.KindString, is.KindList, is.KindMap}},
+					{Name: "UniqueBy", Code: is.ViolationUnique},
+				}},
+				{Path: "qty", Type: reflect.TypeFor[int](), Rules: []is.RuleSpec{
+					{Name: "Between", Code: is.ViolationBetween, Params: map[string]any{"min": 1, "max": 99}, Kinds: []is.Kind{is.KindNumber}},
+				}},
+			}}}},
+			{Path: "tags", Type: reflect.TypeFor[[]string](), Fields: []valid.FieldSpec{{Path: "*", Type: reflect.TypeFor[string](), Rules: []is.RuleSpec{
+				{Name: "OneOf", Code: is.ViolationOneOf, Params: map[string]any{"values": []any{"a", "b"}}},
+			}}}},
+		}, specs)
+	})
+
+	t.Run("recursive types and panicking Valid", func(t *testing.T) {
+		t.Parallel()
+		nodeType := reflect.TypeFor[*describedNode]()
+		specs := valid.Describe(valid.Nested("", &describedNode{}), valid.Nested("owner", describedPanic{}))
+		assert.Equal(t, []valid.FieldSpec{
+			{Path: "name", Type: reflect.TypeFor[string](), Rules: []is.RuleSpec{{Name: "Required", Code: is.ViolationRequired}}},
+			{Path: "children", Type: reflect.TypeFor[[]*describedNode](), Fields: []valid.FieldSpec{{Path: "*", Type: nodeType}}},
+			{Path: "owner", Type: reflect.TypeFor[describedPanic]()},
+		}, specs)
+	})
+
+	t.Run("recursive types validated with Slice", func(t *testing.T) {
+		t.Parallel()
+		specs := valid.Describe(valid.Nested("", &describedTree{}))
+		assert.Equal(t, []valid.FieldSpec{
+			{Path: "name", Type: reflect.TypeFor[string](), Rules: []is.RuleSpec{{Name: "Required", Code: is.ViolationRequired}}},
+			{Path: "children", Type: reflect.TypeFor[[]*describedTree](), Fields: []valid.FieldSpec{{Path: "*", Type: reflect.TypeFor[*describedTree]()}}},
+		}, specs)
+	})
+
+	t.Run("Slice callback and Parse", func(t *testing.T) {
+		t.Parallel()
+		var out time.Time
+		specs := valid.Describe(
+			valid.Slice("items", []describedLine(nil), func(ctx context.Context, _ int, l describedLine) error {
+				return valid.Struct(ctx, valid.Field("qty", l.Qty, is.Positive))
+			}),
+			valid.Parse(&out, "start", "", is.TimeParser(time.RFC3339), is.Required),
+		)
+		require.Len(t, specs, 2)
+		assert.Equal(t, "qty", specs[0].Fields[0].Fields[0].Path)
+		assert.Equal(t, []is.RuleSpec{{
+			Name:   "Parse",
+			Params: map[string]any{"type": reflect.TypeFor[time.Time]()},
+			Rules:  []is.RuleSpec{{Name: "Required", Code: is.ViolationRequired}},
+		}}, specs[1].Rules)
+	})
+
+	t.Run("validation is unaffected", func(t *testing.T) {
+		t.Parallel()
+		err := valid.Struct(context.Background(), valid.Nested("lines", []describedLine{{SKU: "A", Qty: 0}}))
+		require.Equal(t, "lines.0.qty", valid.As(err).Fields[0].Path)
+	})
+}
+
+// ---- Schema -----------------------------------------------------------------
+
+type schemaAddress struct {
+	Country string
+	Postal  string
+}
+
+type schemaUser struct {
+	Email    string
+	Nickname string
+	Home     schemaAddress
+	Work     *schemaAddress
+}
+
+var (
+	addressSchema = valid.NewSchema(
+		valid.Prop("country", func(a *schemaAddress) string { return a.Country }, is.Required, is.ISO3166Alpha2),
+		valid.Prop("postal", func(a *schemaAddress) string { return a.Postal }, is.Required),
+	)
+	userSchema = valid.NewSchema(
+		valid.Prop("email", func(u *schemaUser) string { return u.Email }, is.Required, is.Email),
+		valid.Prop("nickname", func(u *schemaUser) string { return u.Nickname }, is.MinLength(2)),
+		valid.PropSchema("home", func(u *schemaUser) *schemaAddress { return &u.Home }, addressSchema),
+		valid.PropSchema("work", func(u *schemaUser) *schemaAddress { return u.Work }, addressSchema.Omit("postal")),
+	)
+)
+
+func schemaPaths(err error) []string {
+	var paths []string
+	for _, fe := range valid.As(err).Fields {
+		paths = append(paths, fe.Path+" "+fe.Code)
+	}
+	return paths
+}
+
+func TestSchema(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	t.Run("validates properties and nested schemas", func(t *testing.T) {
+		t.Parallel()
+		err := userSchema.Validate(ctx, &schemaUser{Nickname: "x", Work: &schemaAddress{Country: "ZZ"}})
+		assert.Equal(t, []string{
+			"email " + string(is.ViolationRequired),
+			"nickname " + string(is.ViolationMinLength),
+			"home.country " + string(is.ViolationRequired),
+			"home.postal " + string(is.ViolationRequired),
+			"work.country " + string(is.ViolationCountry),
+		}, schemaPaths(err))
+
+		require.NoError(t, userSchema.Validate(ctx, &schemaUser{Email: "a@example.com", Nickname: "al", Home: schemaAddress{Country: "FR", Postal: "75001"}}))
+		require.NoError(t, userSchema.Validate(ctx, nil))
+	})
+
+	t.Run("Extend, Pick, Omit and Partial", func(t *testing.T) {
+		t.Parallel()
+		u := &schemaUser{Nickname: "x"}
+
+		err := userSchema.Pick("email", "nickname").Extend(
+			valid.Prop("nickname", func(u *schemaUser) string { return u.Nickname }, is.MaxLength(1)),
+		).Validate(ctx, u)
+		assert.Equal(t, []string{"email " + string(is.ViolationRequired)}, schemaPaths(err))
+
+		err = userSchema.Pick("email").Extend(
+			valid.Prop("phone", func(*schemaUser) string { return "" }, is.Required),
+		).Validate(ctx, u)
+		assert.Equal(t, []string{
+			"email " + string(is.ViolationRequired),
+			"phone " + string(is.ViolationRequired),
+		}, schemaPaths(err))
+
+		err = userSchema.Omit("email", "home").Validate(ctx, u)
+		assert.Equal(t, []string{"nickname " + string(is.ViolationMinLength)}, schemaPaths(err))
+
+		partial := userSchema.Partial()
+		require.NoError(t, partial.Omit("home").Validate(ctx, &schemaUser{}))
+		err = partial.Omit("home").Validate(ctx, u)
+		assert.Equal(t, []string{"nickname " + string(is.ViolationMinLength)}, schemaPaths(err))
+
+		assert.Len(t, userSchema.Props(), 4, "derived schemas leave s unchanged")
+		assert.PanicsWithValue(t, "valid.Schema.Pick: unknown path phone", func() { userSchema.Pick("phone") })
+	})
+
+	t.Run("Bind and Group", func(t *testing.T) {
+		t.Parallel()
+		var v valid.Validatable = addressSchema.Bind(&schemaAddress{Country: "FR"})
+		err := valid.Struct(ctx,
+			valid.Nested("billing", v),
+			userSchema.Pick("email").Group(&schemaUser{}),
+		)
+		assert.Equal(t, []string{
+			"billing.postal " + string(is.ViolationRequired),
+			"email " + string(is.ViolationRequired),
+		}, schemaPaths(err))
+	})
+
+	t.Run("Describe", func(t *testing.T) {
+		t.Parallel()
+		str := reflect.TypeFor[string]()
+		addrType := reflect.TypeFor[schemaAddress]()
+		required := is.RuleSpec{Name: "Required", Code: is.ViolationRequired}
+		country := is.RuleSpec{Name: "ISO3166Alpha2", Code: is.ViolationCountry, Kinds: []is.Kind{is.KindString}}
+		assert.Equal(t, []valid.FieldSpec{
+			{Path: "email", Type: str, Rules: []is.RuleSpec{
+				{Name: "Email", Code: is.ViolationEmail, Kinds: []is.Kind{is.KindString}},
+			}},
+			{Path: "work", Type: addrType, Fields: []valid.FieldSpec{
+				{Path: "country", Type: str, Rules: []is.RuleSpec{required, country}},
+			}},
+		}, userSchema.Pick("email", "work").Partial().Describe())
+
+		specs := valid.Describe(valid.Nested("", addressSchema.Bind(nil)))
+		assert.Equal(t, []valid.FieldSpec{
+			{Path: "country", Type: str, Rules: []is.RuleSpec{required, country}},
+			{Path: "postal", Type: str, Rules: []is.RuleSpec{required}},
+		}, specs)
+	})
+}
+
 // ---- As ---------------------------------------------------------------------
 
 func TestAs(t *testing.T) {
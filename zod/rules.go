@@ -0,0 +1,207 @@
+package zod
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/alexisvisco/valid/is"
+	"github.com/alexisvisco/valid/jsonschema"
+)
+
+// patterns are the regular expressions checking the rules implemented
+// without one in the is package. Rules that use one describe it in the
+// "pattern" param of their is.RuleSpec.
+var patterns = map[string]string{
+	// An approximation of net/mail.ParseAddress: the server may still
+	// reject unusual addresses.
+	"Email": `^[^\s@<>()]+@[^\s@<>()]+$`,
+	// Charset rules accept the empty string.
+	"ASCII":            `^[\x00-\x7F]*$`,
+	"Letters":          `^[\p{L}\p{M}]*$`,
+	"LettersAndDigits": `^[\p{L}\p{M}\p{Nd}]*$`,
+	"Lowercase":        `^[^\p{Lu}\p{Lt}]*$`,
+	"Uppercase":        `^[^\p{Ll}\p{Lt}]*$`,
+	"NoControlChars":   `^\P{Cc}*$`,
+}
+
+// units are the JavaScript expressions measuring v in an is.LengthUnit.
+// is.Width has none, so its rules are checked by the server only.
+var units = map[is.LengthUnit]string{
+	is.Bytes:     "byteLength(v)",
+	is.Runes:     "runeLength(v)",
+	is.Graphemes: "graphemeLength(v)",
+	is.UTF16:     "v.length",
+}
+
+// unitNames are the names of the is.LengthUnit params written to issues.
+var unitNames = map[is.LengthUnit]string{
+	is.Bytes:     "bytes",
+	is.Runes:     "runes",
+	is.Graphemes: "graphemes",
+	is.UTF16:     "utf16",
+}
+
+// checkOf returns the TypeScript expression of v that is true when v
+// satisfies the rule described by spec, for a schema of JSON type typ. It
+// returns false when the rule has no TypeScript equivalent.
+func checkOf(spec is.RuleSpec, typ string) (string, bool) {
+	p := spec.Params
+	switch spec.Name {
+	case "Required":
+		switch typ {
+		case "string":
+			return `v !== undefined && v !== null && v !== ""`, true
+		case "number", "integer":
+			return "v !== undefined && v !== null && v !== 0", true
+		case "boolean":
+			return "v === true", true
+		default:
+			return "v !== undefined && v !== null", true
+		}
+	case "NotEmpty":
+		return "size(v) > 0", true
+	case "MinLength":
+		return fmt.Sprintf("size(v) >= %s", literal(p["min"])), true
+	case "MaxLength":
+		return fmt.Sprintf("size(v) <= %s", literal(p["max"])), true
+	case "Length":
+		return fmt.Sprintf("size(v) >= %s && size(v) <= %s", literal(p["min"]), literal(p["max"])), true
+	case "MinLengthIn", "MaxLengthIn", "LengthIn":
+		unit, _ := p["unit"].(is.LengthUnit)
+		n, ok := units[unit]
+		if !ok {
+			return "", false
+		}
+		var checks []string
+		if min, ok := p["min"]; ok {
+			checks = append(checks, fmt.Sprintf("%s >= %s", n, literal(min)))
+		}
+		if max, ok := p["max"]; ok {
+			checks = append(checks, fmt.Sprintf("%s <= %s", n, literal(max)))
+		}
+		return strings.Join(checks, " && "), true
+	case "MinItems":
+		return fmt.Sprintf("size(v) >= %s", literal(p["min"])), true
+	case "MaxItems":
+		return fmt.Sprintf("size(v) <= %s", literal(p["max"])), true
+	case "Min":
+		return fmt.Sprintf("v >= %s", literal(p["min"])), true
+	case "Max":
+		return fmt.Sprintf("v <= %s", literal(p["max"])), true
+	case "Between":
+		return fmt.Sprintf("v >= %s && v <= %s", literal(p["min"]), literal(p["max"])), true
+	case "GreaterThan":
+		return fmt.Sprintf("v > %s", literal(p["value"])), true
+	case "GreaterThanOrEqual":
+		return fmt.Sprintf("v >= %s", literal(p["value"])), true
+	case "LessThan":
+		return fmt.Sprintf("v < %s", literal(p["value"])), true
+	case "LessThanOrEqual":
+		return fmt.Sprintf("v <= %s", literal(p["value"])), true
+	case "Positive":
+		return "v > 0", true
+	case "NonNegative":
+		return "v >= 0", true
+	case "MultipleOf":
+		return fmt.Sprintf("multipleOf(v, %s)", literal(p["step"])), true
+	case "Finite":
+		return "Number.isFinite(v)", true
+	case "Integer":
+		if typ == "string" {
+			return regexTest(`^[-+]?[0-9]+$`), true
+		}
+		return "Number.isInteger(v)", true
+	case "Latitude":
+		return "v >= -90 && v <= 90", true
+	case "Longitude":
+		return "v >= -180 && v <= 180", true
+	case "Equal":
+		if _, err := marshal(p["value"]); err != nil {
+			return "", false
+		}
+		return fmt.Sprintf("v === %s", literal(p["value"])), true
+	case "EqualFold":
+		return fmt.Sprintf("v.toLowerCase() === %s", literal(strings.ToLower(fmt.Sprint(p["value"])))), true
+	case "OneOf":
+		if _, err := marshal(p["values"]); err != nil {
+			return "", false
+		}
+		return fmt.Sprintf("%s.includes(v)", literal(p["values"])), true
+	case "OneOfFold":
+		values, _ := p["values"].([]any)
+		lower := make([]string, len(values))
+		for i, v := range values {
+			lower[i] = strings.ToLower(fmt.Sprint(v))
+		}
+		return fmt.Sprintf("%s.includes(v.toLowerCase())", literal(lower)), true
+	case "Matches":
+		return patternTest(p)
+	case "HasPrefix":
+		return fmt.Sprintf("v.startsWith(%s)", literal(p["prefix"])), true
+	case "HasPrefixFold":
+		return fmt.Sprintf("v.toLowerCase().startsWith(%s)", literal(strings.ToLower(fmt.Sprint(p["prefix"])))), true
+	case "HasSuffix":
+		return fmt.Sprintf("v.endsWith(%s)", literal(p["suffix"])), true
+	case "Contains":
+		return fmt.Sprintf("v.includes(%s)", literal(p["value"])), true
+	case "URL":
+		return `URL.canParse(v) && new URL(v).host !== ""`, true
+	case "Unique":
+		return "new Set(v.map((e) => JSON.stringify(e))).size === v.length", true
+	case "NoLeadingTrailingSpace":
+		return "v.trim() === v", true
+	case "NotNilUUID":
+		check, ok := patternTest(p)
+		if !ok {
+			return "", false
+		}
+		return check + ` && !/^[0-]+$/.test(v)`, true
+	}
+	if pattern, ok := patterns[spec.Name]; ok {
+		return regexTest(pattern), true
+	}
+	return patternTest(p)
+}
+
+// patternTest returns a check of v against the "pattern" param of a rule.
+// It returns false when there is none or it has no ECMAScript equivalent.
+func patternTest(params map[string]any) (string, bool) {
+	pattern, ok := params["pattern"].(string)
+	if !ok {
+		return "", false
+	}
+	source, flags, ok := jsonschema.ECMAScriptPattern(pattern)
+	if !ok {
+		return "", false
+	}
+	return fmt.Sprintf("new RegExp(%s, %s).test(v)", literal(source), literal("u"+flags)), true
+}
+
+// regexTest returns a check of v against pattern. Patterns use the "u" flag
+// so that Unicode classes such as \p{L} work as in Go.
+func regexTest(pattern string) string {
+	return fmt.Sprintf("new RegExp(%s, \"u\").test(v)", literal(pattern))
+}
+
+// literal returns v as a TypeScript literal.
+func literal(v any) string {
+	b, err := marshal(v)
+	if err != nil {
+		return "undefined"
+	}
+	return string(b)
+}
+
+// marshal is like json.Marshal without escaping HTML characters, which
+// TypeScript sources do not need.
+func marshal(v any) ([]byte, error) {
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(b.Bytes(), []byte("\n")), nil
+}
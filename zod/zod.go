@@ -0,0 +1,296 @@
+// Package zod generates Zod schemas (TypeScript) from validation rules, so
+// that web clients check the same constraints as the server and report the
+// same violation codes and messages before sending a request.
+package zod
+
+import (
+	"bytes"
+	"fmt"
+	"maps"
+	"reflect"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/alexisvisco/valid"
+	"github.com/alexisvisco/valid/is"
+	"github.com/alexisvisco/valid/jsonschema"
+)
+
+// File is a TypeScript module of Zod schemas. Add types with Add and write it
+// with Bytes.
+type File struct {
+	schemas map[string]*jsonschema.Schema
+	// roots maps each schema to the schema "#" references resolve to.
+	roots map[string]string
+	order []string
+	// types holds the schema names of the types added with Add.
+	types map[reflect.Type]string
+}
+
+// NewFile returns an empty File.
+func NewFile() *File {
+	return &File{schemas: map[string]*jsonschema.Schema{}, roots: map[string]string{}, types: map[reflect.Type]string{}}
+}
+
+// Add adds the schema of T to f and returns its name. The schema is derived
+// from the Valid method of T like jsonschema.For: property names are the
+// paths given to valid.Field and valid.Nested, and named types validated
+// with valid.Nested get schemas of their own.
+//
+// For a type named Signup, f exports SignupSchema and the inferred type
+// Signup. Adding a type twice keeps the first schema. A type whose name is
+// already used by another type gets a numeric suffix (e.g. "address2" for an
+// address type of another package).
+func Add[T valid.Validatable](f *File) string {
+	t := reflect.TypeFor[T]()
+	var v any
+	if t.Kind() == reflect.Pointer {
+		v = reflect.New(t.Elem()).Interface()
+	} else {
+		var zero T
+		v = zero
+	}
+	name := f.name(t)
+	if _, ok := f.schemas[name]; ok {
+		return name
+	}
+	s := jsonschema.GenerateWith(t, valid.Describe(valid.Nested("", v)), jsonschema.Options{Nullable: true, RuleSpecs: true, DefName: f.name})
+	f.add(name, s, name)
+	defs := make([]string, 0, len(s.Defs))
+	for def := range s.Defs {
+		defs = append(defs, def)
+	}
+	sort.Strings(defs)
+	for _, def := range defs {
+		f.add(def, s.Defs[def], name)
+	}
+	s.Defs = nil
+	return name
+}
+
+func (f *File) add(name string, s *jsonschema.Schema, root string) {
+	if _, ok := f.schemas[name]; ok {
+		return
+	}
+	f.schemas[name] = s
+	f.roots[name] = root
+	f.order = append(f.order, name)
+}
+
+// name returns the schema name of t: the name of the type, with a numeric
+// suffix when another type already uses it.
+func (f *File) name(t reflect.Type) string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if name, ok := f.types[t]; ok {
+		return name
+	}
+	base := typeName(t.Name())
+	name := base
+	for i := 2; slices.Contains(slices.Collect(maps.Values(f.types)), name); i++ {
+		name = base + strconv.Itoa(i)
+	}
+	f.types[t] = name
+	return name
+}
+
+// Bytes returns the TypeScript source of f.
+//
+// Each property is optional at the Zod level, like an absent JSON field
+// decoded to its zero value, and rules are checked with refinements that
+// skip undefined and null values, except is.Required. A failed refinement
+// reports the message of the Go rule and the params of its is.RuleSpec, with
+// its violation code in params.code:
+//
+//	{ message: "length must be >= 2", params: { code: "VALIDATION_MIN_LENGTH", min: 2 } }
+//
+// Rules without a TypeScript equivalent (e.g. is.Password, custom rules) are
+// listed in a comment and left to the server.
+func (f *File) Bytes() []byte {
+	var b bytes.Buffer
+	b.WriteString("// Code generated by valid/zod. DO NOT EDIT.\n\n")
+	b.WriteString("import { z } from \"zod\";\n\n")
+	b.WriteString(helpers)
+	for _, name := range f.order {
+		e := &emitter{root: f.roots[name]}
+		expr := e.schema(f.schemas[name], 0)
+		for _, rule := range e.serverOnly {
+			fmt.Fprintf(&b, "// Checked by the server only: %s.\n", rule)
+		}
+		fmt.Fprintf(&b, "export const %sSchema = %s;\n", name, expr)
+		fmt.Fprintf(&b, "export type %s = z.infer<typeof %sSchema>;\n\n", name, name)
+	}
+	return b.Bytes()
+}
+
+// helpers is written at the top of every File.
+const helpers = `const byteLength = (s: string) => new TextEncoder().encode(s).length;
+const runeLength = (s: string) => [...s].length;
+const graphemeLength = (s: string) => [...new Intl.Segmenter().segment(s)].length;
+const size = (v: string | unknown[] | Record<string, unknown>) =>
+  typeof v === "string" ? byteLength(v) : Array.isArray(v) ? v.length : Object.keys(v).length;
+const multipleOf = (v: number, step: number) => Math.abs(v / step - Math.round(v / step)) < 1e-9;
+
+`
+
+type emitter struct {
+	root       string
+	serverOnly []string
+}
+
+// schema returns the Zod expression of s, indented by depth levels.
+func (e *emitter) schema(s *jsonschema.Schema, depth int) string {
+	if s == nil {
+		return "z.unknown()"
+	}
+	if s.Ref != "" {
+		name := e.root
+		if def, ok := strings.CutPrefix(s.Ref, "#/$defs/"); ok {
+			name = typeName(def)
+		}
+		return fmt.Sprintf("z.lazy((): z.ZodTypeAny => %sSchema)", name)
+	}
+
+	var expr string
+	typ := ""
+	for _, t := range s.Type {
+		if t != "null" {
+			typ = t
+			break
+		}
+	}
+	switch typ {
+	case "string":
+		expr = "z.string()"
+	case "integer":
+		expr = "z.number().int()"
+	case "number":
+		expr = "z.number()"
+	case "boolean":
+		expr = "z.boolean()"
+	case "array":
+		expr = fmt.Sprintf("z.array(%s)", e.element(s.Items, depth))
+	case "object":
+		if s.Properties != nil {
+			expr = e.object(s, depth)
+		} else {
+			expr = fmt.Sprintf("z.record(z.string(), %s)", e.element(s.AdditionalProperties, depth))
+		}
+	default:
+		expr = "z.unknown()"
+	}
+	if s.Type.Has("null") {
+		expr += ".nullable()"
+	}
+	return expr
+}
+
+// element returns the Zod expression of an array item or map value, with its
+// rules.
+func (e *emitter) element(s *jsonschema.Schema, depth int) string {
+	expr := e.schema(s, depth)
+	if s != nil {
+		expr += e.refinements(s, depth+1)
+	}
+	return expr
+}
+
+func (e *emitter) object(s *jsonschema.Schema, depth int) string {
+	names := make([]string, 0, len(s.Properties))
+	for name := range s.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	indent := strings.Repeat("  ", depth+1)
+	var b strings.Builder
+	b.WriteString("z.object({\n")
+	for _, name := range names {
+		p := s.Properties[name]
+		key := name
+		if !identifier.MatchString(name) {
+			key = strconv.Quote(name)
+		}
+		fmt.Fprintf(&b, "%s%s: %s.optional()%s,\n", indent, key, e.schema(p, depth+1), e.refinements(p, depth+2))
+	}
+	b.WriteString(strings.Repeat("  ", depth) + "})")
+	return b.String()
+}
+
+var identifier = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// refinements returns the .refine calls checking the rules of s.
+func (e *emitter) refinements(s *jsonschema.Schema, depth int) string {
+	specs, _ := s.Extensions["x-rules"].([]is.RuleSpec)
+	typ := ""
+	for _, t := range s.Type {
+		if t != "null" {
+			typ = t
+			break
+		}
+	}
+	var b strings.Builder
+	for _, spec := range specs {
+		check, ok := checkOf(spec, typ)
+		if !ok {
+			if !slices.Contains(e.serverOnly, spec.Name) {
+				e.serverOnly = append(e.serverOnly, spec.Name)
+			}
+			continue
+		}
+		if spec.Name == "Required" {
+			check = fmt.Sprintf("(v) => %s", check)
+		} else {
+			check = fmt.Sprintf("(v) => v === undefined || v === null || %s", check)
+		}
+		fmt.Fprintf(&b, "\n%s.refine(%s, %s)", strings.Repeat("  ", depth), check, issue(spec))
+	}
+	return b.String()
+}
+
+// issue returns the custom issue options of spec: its message and params,
+// with its code.
+func issue(spec is.RuleSpec) string {
+	params := map[string]any{"code": spec.Code}
+	for k, v := range spec.Params {
+		if unit, ok := v.(is.LengthUnit); ok {
+			v = unitNames[unit]
+		}
+		params[k] = v
+	}
+	p, err := marshal(params)
+	if err != nil {
+		p, _ = marshal(map[string]any{"code": spec.Code})
+	}
+	return fmt.Sprintf("{ message: %s, params: %s }", strconv.Quote(Message(spec)), p)
+}
+
+// Message returns the message of the violation reported by spec, formatted
+// like the message of the Go rule: list params are joined with ", ".
+func Message(spec is.RuleSpec) string {
+	params := make(map[string]any, len(spec.Params))
+	for k, v := range spec.Params {
+		if list, ok := v.([]any); ok {
+			parts := make([]string, len(list))
+			for i, item := range list {
+				parts[i] = fmt.Sprint(item)
+			}
+			v = strings.Join(parts, ", ")
+		}
+		params[k] = v
+	}
+	return is.FormatMessage(spec.Code, params)
+}
+
+// typeName returns a TypeScript identifier for a Go type or $defs name.
+func typeName(name string) string {
+	name = regexp.MustCompile(`[^A-Za-z0-9_$]+`).ReplaceAllString(name, "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "_" + name
+	}
+	return name
+}
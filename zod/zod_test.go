@@ -0,0 +1,160 @@
+package zod_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/alexisvisco/valid"
+	"github.com/alexisvisco/valid/is"
+	"github.com/alexisvisco/valid/ishelper"
+	"github.com/alexisvisco/valid/zod"
+	"github.com/stretchr/testify/assert"
+)
+
+type address struct {
+	City string
+}
+
+func (a address) Valid(ctx context.Context) error {
+	return valid.Struct(ctx, valid.Field("city", a.City, is.Required, is.MaxLengthIn(is.Runes, 80)))
+}
+
+type createUser struct {
+	Email    string
+	Age      int
+	Plan     string
+	Tags     []string
+	Nickname ishelper.Optional
+	Password string
+	Home     address
+	Manager  *createUser
+}
+
+func (u createUser) Valid(ctx context.Context) error {
+	return valid.Struct(ctx,
+		valid.Field("email", u.Email, is.Required, is.Email),
+		valid.Field("age", u.Age, is.Between(18, 130)),
+		valid.Field("plan", u.Plan, is.OneOf("free", "pro")),
+		valid.Field("tags", u.Tags, is.MaxItems(5), is.Unique),
+		valid.Each("tags", u.Tags, is.Matches(`^[a-z]+$`)),
+		valid.Field("nickname", ishelper.None[string](), is.MinLength(2)),
+		valid.Field("password", u.Password, is.Password(is.PasswordPolicy{MinLength: 12})),
+		valid.Nested("home", u.Home),
+		valid.Nested("manager", u.Manager),
+	)
+}
+
+func TestAdd(t *testing.T) {
+	t.Parallel()
+
+	f := zod.NewFile()
+	assert.Equal(t, "createUser", zod.Add[createUser](f))
+	assert.Equal(t, "createUser", zod.Add[*createUser](f))
+	got := string(f.Bytes())
+
+	for _, want := range []string{
+		`import { z } from "zod";`,
+		`// Checked by the server only: Password.`,
+		`export const createUserSchema = z.object({`,
+		`  age: z.number().int().optional()
+    .refine((v) => v === undefined || v === null || v >= 18 && v <= 130, { message: "must be between 18 and 130", params: {"code":"VALIDATION_BETWEEN","max":130,"min":18} }),`,
+		`  email: z.string().optional()
+    .refine((v) => v !== undefined && v !== null && v !== "", { message: "is required", params: {"code":"VALIDATION_REQUIRED"} })
+    .refine((v) => v === undefined || v === null || new RegExp("^[^\\s@<>()]+@[^\\s@<>()]+$", "u").test(v), { message: "must be a valid email", params: {"code":"VALIDATION_EMAIL"} }),`,
+		`  home: z.lazy((): z.ZodTypeAny => addressSchema).optional(),`,
+		`  manager: z.lazy((): z.ZodTypeAny => createUserSchema).optional(),`,
+		`  nickname: z.string().nullable().optional()
+    .refine((v) => v === undefined || v === null || size(v) >= 2, { message: "length must be >= 2", params: {"code":"VALIDATION_MIN_LENGTH","min":2} }),`,
+		`  password: z.string().optional(),`,
+		`["free","pro"].includes(v), { message: "must be one of free, pro", params: {"code":"VALIDATION_ONE_OF","values":["free","pro"]} }),`,
+		`  tags: z.array(z.string()
+    .refine((v) => v === undefined || v === null || new RegExp("^[a-z]+$", "u").test(v), { message: "must match pattern ^[a-z]+$", params: {"code":"VALIDATION_MATCHES","pattern":"^[a-z]+$"} })).optional()
+    .refine((v) => v === undefined || v === null || size(v) <= 5, { message: "must contain at most 5 items", params: {"code":"VALIDATION_MAX_ITEMS","max":5} })`,
+		`export type createUser = z.infer<typeof createUserSchema>;`,
+		`runeLength(v) <= 80, { message: "length must be <= 80", params: {"code":"VALIDATION_MAX_LENGTH","max":80,"unit":"runes"} }),`,
+		`export type address = z.infer<typeof addressSchema>;`,
+	} {
+		assert.Contains(t, got, want)
+	}
+	assert.Equal(t, 1, strings.Count(got, "export const addressSchema"))
+}
+
+type box[T any] struct {
+	Label string
+}
+
+func (b box[T]) Valid(ctx context.Context) error {
+	return valid.Struct(ctx, valid.Field("label", b.Label, is.Required))
+}
+
+func TestAddNameCollision(t *testing.T) {
+	t.Parallel()
+
+	// Both names sanitize to "box_int_".
+	f := zod.NewFile()
+	assert.Equal(t, "box_int_", zod.Add[box[[]int]](f))
+	assert.Equal(t, "box_int_2", zod.Add[box[*int]](f))
+	assert.Equal(t, "box_int_", zod.Add[*box[[]int]](f))
+	got := string(f.Bytes())
+	assert.Equal(t, 1, strings.Count(got, "export const box_int_Schema"))
+	assert.Equal(t, 1, strings.Count(got, "export const box_int_2Schema"))
+}
+
+type lookup struct {
+	Lang string
+	Code string
+}
+
+func (l lookup) Valid(ctx context.Context) error {
+	return valid.Struct(ctx,
+		valid.Field("lang", l.Lang, is.Matches(`(?i)^(?P<lang>[a-z]{2})\z`)),
+		valid.Field("code", l.Code, is.Matches(`(?U)^a+`)),
+	)
+}
+
+func TestAddPatterns(t *testing.T) {
+	t.Parallel()
+
+	f := zod.NewFile()
+	zod.Add[lookup](f)
+	got := string(f.Bytes())
+
+	assert.Contains(t, got, `new RegExp("^(?<lang>[a-z]{2})$", "ui").test(v)`)
+	assert.Contains(t, got, `// Checked by the server only: Matches.`)
+	assert.Contains(t, got, `  code: z.string().optional(),`)
+}
+
+type contact struct {
+	Phone string
+	ID    string
+}
+
+func (c contact) Valid(ctx context.Context) error {
+	return valid.Struct(ctx,
+		valid.Field("phone", c.Phone, is.E164),
+		valid.Field("id", c.ID, is.NotNilUUID),
+	)
+}
+
+func TestAddRulePatterns(t *testing.T) {
+	t.Parallel()
+
+	f := zod.NewFile()
+	zod.Add[contact](f)
+	got := string(f.Bytes())
+
+	// Patterns come from the "pattern" param of the rules' specs.
+	assert.Contains(t, got, `new RegExp("^\\+[1-9][0-9]{1,14}$", "u").test(v)`)
+	assert.Contains(t, got, `new RegExp("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$", "u").test(v) && !/^[0-]+$/.test(v)`)
+}
+
+func TestMessage(t *testing.T) {
+	t.Parallel()
+
+	spec, ok := is.Describe(is.UUIDVersion(4, 7))
+	assert.True(t, ok)
+	assert.Equal(t, "must be a UUID of version 4, 7", zod.Message(spec))
+	spec, _ = is.Describe(is.Length(2, 5))
+	assert.Equal(t, "length must be between 2 and 5", zod.Message(spec))
+}